@@ -0,0 +1,133 @@
+package chronofield
+
+import (
+	"fmt"
+
+	"github.com/linsongze/go-date-time/datetime/timeconst"
+)
+
+type ChronoField int
+
+const (
+	NANO_OF_SECOND ChronoField = iota
+	NANO_OF_DAY
+	MICRO_OF_SECOND
+	MICRO_OF_DAY
+	MILLI_OF_SECOND
+	MILLI_OF_DAY
+	SECOND_OF_MINUTE
+	SECOND_OF_DAY
+	MINUTE_OF_HOUR
+	MINUTE_OF_DAY
+	HOUR_OF_AMPM
+	HOUR_OF_DAY
+	AMPM_OF_DAY
+	DAY_OF_WEEK // Sunday = 0, Monday = 1, ..., Saturday = 6, like GetDayOfWeek
+	DAY_OF_MONTH
+	DAY_OF_QUARTER
+	DAY_OF_YEAR
+	EPOCH_DAY
+	ALIGNED_WEEK_OF_MONTH // week 1 is days 1-7 of the month
+	ALIGNED_WEEK_OF_YEAR  // week 1 is days 1-7 of the year
+	ISO_WEEK              // ISO 8601 week of the week-based year
+	ISO_WEEK_BASED_YEAR
+	MONTH_OF_YEAR
+	QUARTER_OF_YEAR
+	PROLEPTIC_MONTH // months since year 0
+	YEAR
+	INSTANT_SECONDS
+	OFFSET_SECONDS
+)
+
+// ValueRange is the inclusive range of valid values of a field.
+type ValueRange struct {
+	Min int
+	Max int
+}
+
+// IsValidValue checks if the value is within the range.
+func (r ValueRange) IsValidValue(value int) bool {
+	return value >= r.Min && value <= r.Max
+}
+
+// String returns the range as "min - max".
+func (r ValueRange) String() string {
+	return fmt.Sprintf("%d - %d", r.Min, r.Max)
+}
+
+type fieldInfo struct {
+	name       string
+	valueRange ValueRange
+	dateBased  bool
+	timeBased  bool
+}
+
+var fields = map[ChronoField]fieldInfo{
+	NANO_OF_SECOND:        {"NanoOfSecond", ValueRange{0, 999_999_999}, false, true},
+	NANO_OF_DAY:           {"NanoOfDay", ValueRange{0, 86400*1000_000_000 - 1}, false, true},
+	MICRO_OF_SECOND:       {"MicroOfSecond", ValueRange{0, 999_999}, false, true},
+	MICRO_OF_DAY:          {"MicroOfDay", ValueRange{0, 86400*1000_000 - 1}, false, true},
+	MILLI_OF_SECOND:       {"MilliOfSecond", ValueRange{0, 999}, false, true},
+	MILLI_OF_DAY:          {"MilliOfDay", ValueRange{0, 86400*1000 - 1}, false, true},
+	SECOND_OF_MINUTE:      {"SecondOfMinute", ValueRange{0, 59}, false, true},
+	SECOND_OF_DAY:         {"SecondOfDay", ValueRange{0, 86400 - 1}, false, true},
+	MINUTE_OF_HOUR:        {"MinuteOfHour", ValueRange{0, 59}, false, true},
+	MINUTE_OF_DAY:         {"MinuteOfDay", ValueRange{0, 24*60 - 1}, false, true},
+	HOUR_OF_AMPM:          {"HourOfAmPm", ValueRange{0, 11}, false, true},
+	HOUR_OF_DAY:           {"HourOfDay", ValueRange{0, 23}, false, true},
+	AMPM_OF_DAY:           {"AmPmOfDay", ValueRange{0, 1}, false, true},
+	DAY_OF_WEEK:           {"DayOfWeek", ValueRange{0, 6}, true, false},
+	DAY_OF_MONTH:          {"DayOfMonth", ValueRange{1, 31}, true, false},
+	DAY_OF_QUARTER:        {"DayOfQuarter", ValueRange{1, 92}, true, false},
+	DAY_OF_YEAR:           {"DayOfYear", ValueRange{1, 366}, true, false},
+	EPOCH_DAY:             {"EpochDay", ValueRange{-365243219162, 365241780471}, true, false},
+	ALIGNED_WEEK_OF_MONTH: {"AlignedWeekOfMonth", ValueRange{1, 5}, true, false},
+	ALIGNED_WEEK_OF_YEAR:  {"AlignedWeekOfYear", ValueRange{1, 53}, true, false},
+	ISO_WEEK:              {"IsoWeek", ValueRange{1, 53}, true, false},
+	ISO_WEEK_BASED_YEAR:   {"IsoWeekBasedYear", ValueRange{timeconst.MIN_YEAR, timeconst.MAX_YEAR}, true, false},
+	MONTH_OF_YEAR:         {"MonthOfYear", ValueRange{1, 12}, true, false},
+	QUARTER_OF_YEAR:       {"QuarterOfYear", ValueRange{1, 4}, true, false},
+	PROLEPTIC_MONTH:       {"ProlepticMonth", ValueRange{timeconst.MIN_YEAR * 12, timeconst.MAX_YEAR*12 + 11}, true, false},
+	YEAR:                  {"Year", ValueRange{timeconst.MIN_YEAR, timeconst.MAX_YEAR}, true, false},
+	INSTANT_SECONDS:       {"InstantSeconds", ValueRange{-1 << 63, 1<<63 - 1}, false, false},
+	OFFSET_SECONDS:        {"OffsetSeconds", ValueRange{-18 * 3600, 18 * 3600}, false, false},
+}
+
+// Values returns all fields, from the smallest to the largest.
+func Values() []ChronoField {
+	values := make([]ChronoField, 0, len(fields))
+	for f := NANO_OF_SECOND; f <= OFFSET_SECONDS; f++ {
+		values = append(values, f)
+	}
+	return values
+}
+
+// IsValid checks if the field is one of the defined fields.
+func (f ChronoField) IsValid() bool {
+	_, ok := fields[f]
+	return ok
+}
+
+// String returns the name of the field, such as "DayOfMonth".
+func (f ChronoField) String() string {
+	if info, ok := fields[f]; ok {
+		return info.name
+	}
+	return fmt.Sprintf("ChronoField(%d)", int(f))
+}
+
+// Range returns the outer range of valid values of the field, such as 1 - 31 for DAY_OF_MONTH.
+// The range for a specific date may be smaller.
+func (f ChronoField) Range() ValueRange {
+	return fields[f].valueRange
+}
+
+// IsDateBased checks if the field represents a component of a date.
+func (f ChronoField) IsDateBased() bool {
+	return fields[f].dateBased
+}
+
+// IsTimeBased checks if the field represents a component of a time of day.
+func (f ChronoField) IsTimeBased() bool {
+	return fields[f].timeBased
+}
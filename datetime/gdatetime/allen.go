@@ -0,0 +1,222 @@
+package gdatetime
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+	"sync"
+)
+
+// AllenRelation is one of the 13 relations of Allen's interval algebra, exactly one of which holds between two intervals.
+// The relations are ordered so that the inverse of r is AllenAfter - r.
+type AllenRelation int
+
+const (
+	// AllenBefore: a ends before b starts, with a gap.
+	AllenBefore AllenRelation = iota
+	// AllenMeets: a ends exactly where b starts.
+	AllenMeets
+	// AllenOverlaps: a starts before b and ends inside b.
+	AllenOverlaps
+	// AllenStarts: a starts with b and ends before b.
+	AllenStarts
+	// AllenDuring: a starts after b and ends before b.
+	AllenDuring
+	// AllenFinishes: a starts after b and ends with b.
+	AllenFinishes
+	// AllenEquals: a and b have the same start and end.
+	AllenEquals
+	// AllenFinishedBy: a starts before b and ends with b, the inverse of AllenFinishes.
+	AllenFinishedBy
+	// AllenContains: a starts before b and ends after b, the inverse of AllenDuring.
+	AllenContains
+	// AllenStartedBy: a starts with b and ends after b, the inverse of AllenStarts.
+	AllenStartedBy
+	// AllenOverlappedBy: a starts inside b and ends after b, the inverse of AllenOverlaps.
+	AllenOverlappedBy
+	// AllenMetBy: a starts exactly where b ends, the inverse of AllenMeets.
+	AllenMetBy
+	// AllenAfter: a starts after b ends, with a gap, the inverse of AllenBefore.
+	AllenAfter
+)
+
+var allenRelationNames = [...]string{
+	"before", "meets", "overlaps", "starts", "during", "finishes", "equals",
+	"finished-by", "contains", "started-by", "overlapped-by", "met-by", "after",
+}
+
+// AllAllenRelations is the set of the 13 relations, the composition of relations that says nothing.
+const AllAllenRelations AllenRelationSet = 1<<(AllenAfter+1) - 1
+
+// Relate returns the Allen relation of a to b, comparing the start and end instants.
+// An empty interval is related by the same comparisons, so it starts or meets an interval beginning at its instant.
+func Relate(a, b Interval) AllenRelation {
+	return relateEndpoints(
+		a.Start.t.Compare(b.Start.t), a.End.t.Compare(b.End.t),
+		a.End.t.Compare(b.Start.t), a.Start.t.Compare(b.End.t),
+	)
+}
+
+// relateEndpoints returns the relation from the comparisons of the starts, the ends,
+// the end of a with the start of b and the start of a with the end of b.
+func relateEndpoints(starts, ends, endStart, startEnd int) AllenRelation {
+	switch {
+	case starts == 0 && ends == 0:
+		return AllenEquals
+	case starts == 0 && ends < 0:
+		return AllenStarts
+	case starts == 0:
+		return AllenStartedBy
+	case ends == 0 && starts > 0:
+		return AllenFinishes
+	case ends == 0:
+		return AllenFinishedBy
+	case endStart < 0:
+		return AllenBefore
+	case endStart == 0:
+		return AllenMeets
+	case startEnd > 0:
+		return AllenAfter
+	case startEnd == 0:
+		return AllenMetBy
+	case starts > 0 && ends < 0:
+		return AllenDuring
+	case starts < 0 && ends > 0:
+		return AllenContains
+	case starts < 0:
+		return AllenOverlaps
+	default:
+		return AllenOverlappedBy
+	}
+}
+
+// Holds checks if the relation holds from a to b, such as AllenMeets.Holds(a, b).
+func (r AllenRelation) Holds(a, b Interval) bool {
+	return Relate(a, b) == r
+}
+
+// Inverse returns the relation of b to a when r is the relation of a to b, such as AllenMetBy for AllenMeets.
+func (r AllenRelation) Inverse() AllenRelation {
+	return AllenAfter - r
+}
+
+// IsDisjoint checks if the intervals share no instant: before, meets, met-by and after.
+func (r AllenRelation) IsDisjoint() bool {
+	return r == AllenBefore || r == AllenMeets || r == AllenMetBy || r == AllenAfter
+}
+
+// IsInside checks if every instant of a is in b: starts, during, finishes and equals.
+func (r AllenRelation) IsInside() bool {
+	return r == AllenStarts || r == AllenDuring || r == AllenFinishes || r == AllenEquals
+}
+
+// IsEnclosing checks if every instant of b is in a: equals, finished-by, contains and started-by.
+func (r AllenRelation) IsEnclosing() bool {
+	return r == AllenEquals || r == AllenFinishedBy || r == AllenContains || r == AllenStartedBy
+}
+
+// String returns the name of the relation, such as "met-by".
+func (r AllenRelation) String() string {
+	if r < AllenBefore || r > AllenAfter {
+		return fmt.Sprintf("AllenRelation(%d)", int(r))
+	}
+	return allenRelationNames[r]
+}
+
+// AllenRelationSet is a set of Allen relations, the possible relations between two intervals when only some facts are known.
+type AllenRelationSet uint16
+
+// NewAllenRelationSet returns the set of the relations.
+func NewAllenRelationSet(relations ...AllenRelation) AllenRelationSet {
+	var s AllenRelationSet
+	for _, r := range relations {
+		s |= 1 << r
+	}
+	return s
+}
+
+// Has checks if the relation is in the set.
+func (s AllenRelationSet) Has(r AllenRelation) bool {
+	return s&(1<<r) != 0
+}
+
+// Len returns the number of relations in the set.
+func (s AllenRelationSet) Len() int {
+	n := 0
+	for range s.All() {
+		n++
+	}
+	return n
+}
+
+// All returns an iterator over the relations of the set in order.
+func (s AllenRelationSet) All() iter.Seq[AllenRelation] {
+	return func(yield func(AllenRelation) bool) {
+		for r := AllenBefore; r <= AllenAfter; r++ {
+			if s.Has(r) && !yield(r) {
+				return
+			}
+		}
+	}
+}
+
+// Inverse returns the set of the inverses of the relations.
+func (s AllenRelationSet) Inverse() AllenRelationSet {
+	var inverse AllenRelationSet
+	for r := range s.All() {
+		inverse |= 1 << r.Inverse()
+	}
+	return inverse
+}
+
+// Compose returns the possible relations of A to C when the relation of A to B is in s and the relation of B to C is in other.
+func (s AllenRelationSet) Compose(other AllenRelationSet) AllenRelationSet {
+	var composed AllenRelationSet
+	for ab := range s.All() {
+		for bc := range other.All() {
+			composed |= Compose(ab, bc)
+		}
+	}
+	return composed
+}
+
+// String returns the relations of the set, such as "{before, meets, overlaps}".
+func (s AllenRelationSet) String() string {
+	names := make([]string, 0, 13)
+	for r := range s.All() {
+		names = append(names, r.String())
+	}
+	return "{" + strings.Join(names, ", ") + "}"
+}
+
+// Compose returns the possible relations of A to C from the relation of A to B and the relation of B to C,
+// such as {before} for before and meets. The table is Allen's composition table.
+func Compose(ab, bc AllenRelation) AllenRelationSet {
+	return allenCompositionTable()[ab][bc]
+}
+
+// allenCompositionTable is computed once by relating every triple of intervals with endpoints among 8 points,
+// enough for the 6 endpoints of three intervals to be in any order, rather than copying the 169 entries by hand.
+var allenCompositionTable = sync.OnceValue(func() *[13][13]AllenRelationSet {
+	type span struct{ start, end int }
+	var spans []span
+	for start := 0; start < 8; start++ {
+		for end := start + 1; end < 8; end++ {
+			spans = append(spans, span{start, end})
+		}
+	}
+	relate := func(a, b span) AllenRelation {
+		return relateEndpoints(compareInt(a.start, b.start), compareInt(a.end, b.end), compareInt(a.end, b.start), compareInt(a.start, b.end))
+	}
+
+	var table [13][13]AllenRelationSet
+	for _, a := range spans {
+		for _, b := range spans {
+			ab := relate(a, b)
+			for _, c := range spans {
+				table[ab][relate(b, c)] |= 1 << relate(a, c)
+			}
+		}
+	}
+	return &table
+})
@@ -0,0 +1,110 @@
+package gdatetime
+
+import (
+	"testing"
+	"testing/quick"
+)
+
+func hours(t *testing.T, start, end int) Interval {
+	t.Helper()
+	return must(IntervalOf(utcAt(1, start), utcAt(1, end)))
+}
+
+func TestRelate(t *testing.T) {
+	b := hours(t, 10, 14)
+	tests := []struct {
+		a    Interval
+		want AllenRelation
+	}{
+		{hours(t, 6, 8), AllenBefore},
+		{hours(t, 8, 10), AllenMeets},
+		{hours(t, 8, 12), AllenOverlaps},
+		{hours(t, 10, 12), AllenStarts},
+		{hours(t, 11, 13), AllenDuring},
+		{hours(t, 12, 14), AllenFinishes},
+		{hours(t, 10, 14), AllenEquals},
+		{hours(t, 8, 14), AllenFinishedBy},
+		{hours(t, 8, 16), AllenContains},
+		{hours(t, 10, 16), AllenStartedBy},
+		{hours(t, 12, 16), AllenOverlappedBy},
+		{hours(t, 14, 16), AllenMetBy},
+		{hours(t, 16, 18), AllenAfter},
+	}
+	for _, test := range tests {
+		got := Relate(test.a, b)
+		if got != test.want || !test.want.Holds(test.a, b) {
+			t.Errorf("Relate(%v, %v) = %v, want %v", test.a, b, got, test.want)
+		}
+		if inverse := Relate(b, test.a); inverse != test.want.Inverse() {
+			t.Errorf("Relate(%v, %v) = %v, want the inverse %v", b, test.a, inverse, test.want.Inverse())
+		}
+		if got.IsDisjoint() == test.a.Overlaps(b) {
+			t.Errorf("%v: IsDisjoint = %v but Overlaps = %v", got, got.IsDisjoint(), test.a.Overlaps(b))
+		}
+		if got.IsInside() != b.Encloses(test.a) || got.IsEnclosing() != test.a.Encloses(b) {
+			t.Errorf("%v: IsInside or IsEnclosing does not match Encloses", got)
+		}
+	}
+	if AllenMeets.String() != "meets" || AllenOverlappedBy.String() != "overlapped-by" || AllenRelation(13).String() != "AllenRelation(13)" {
+		t.Error("String failed")
+	}
+}
+
+func TestCompose(t *testing.T) {
+	set := NewAllenRelationSet
+	tests := []struct {
+		ab, bc AllenRelation
+		want   AllenRelationSet
+	}{
+		{AllenBefore, AllenBefore, set(AllenBefore)},
+		{AllenMeets, AllenMeets, set(AllenBefore)},
+		{AllenOverlaps, AllenMeets, set(AllenBefore)},
+		{AllenOverlaps, AllenOverlaps, set(AllenBefore, AllenMeets, AllenOverlaps)},
+		{AllenStarts, AllenDuring, set(AllenDuring)},
+		{AllenDuring, AllenDuring, set(AllenDuring)},
+		{AllenMeets, AllenDuring, set(AllenOverlaps, AllenStarts, AllenDuring)},
+		{AllenBefore, AllenDuring, set(AllenBefore, AllenMeets, AllenOverlaps, AllenStarts, AllenDuring)},
+		{AllenMeets, AllenMetBy, set(AllenFinishedBy, AllenEquals, AllenFinishes)},
+		{AllenBefore, AllenAfter, AllAllenRelations},
+	}
+	for _, test := range tests {
+		if got := Compose(test.ab, test.bc); got != test.want {
+			t.Errorf("Compose(%v, %v) = %v, want %v", test.ab, test.bc, got, test.want)
+		}
+	}
+	for r := AllenBefore; r <= AllenAfter; r++ {
+		if Compose(AllenEquals, r) != set(r) || Compose(r, AllenEquals) != set(r) {
+			t.Errorf("equals should be the identity of the composition, failed for %v", r)
+		}
+		for s := AllenBefore; s <= AllenAfter; s++ {
+			// (A r B and B s C) is (C s' B and B r' A)
+			if Compose(r, s).Inverse() != Compose(s.Inverse(), r.Inverse()) {
+				t.Errorf("Compose(%v, %v) does not match the composition of the inverses", r, s)
+			}
+		}
+	}
+	if AllAllenRelations.Len() != 13 || set(AllenBefore, AllenMeets).String() != "{before, meets}" {
+		t.Error("AllenRelationSet failed")
+	}
+	if got := set(AllenMeets).Compose(set(AllenMeets, AllenBefore)); got != set(AllenBefore) {
+		t.Errorf("set Compose = %v, want {before}", got)
+	}
+}
+
+func TestComposeProperty(t *testing.T) {
+	property := func(a, b, c spec) bool {
+		ia, ib, ic := a.interval(), b.interval(), c.interval()
+		if ia.IsEmpty() || ib.IsEmpty() || ic.IsEmpty() {
+			return true
+		}
+		ab, bc, ac := Relate(ia, ib), Relate(ib, ic), Relate(ia, ic)
+		if !Compose(ab, bc).Has(ac) {
+			t.Errorf("%v %v %v: %v not in Compose(%v, %v) = %v", ia, ib, ic, ac, ab, bc, Compose(ab, bc))
+			return false
+		}
+		return true
+	}
+	if err := quick.Check(property, &quick.Config{MaxCount: 2000}); err != nil {
+		t.Error(err)
+	}
+}
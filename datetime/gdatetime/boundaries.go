@@ -0,0 +1,139 @@
+package gdatetime
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/linsongze/go-date-time/datetime/timeunit"
+)
+
+// EndBound decides which instant EndOfWith returns for the end of a period.
+type EndBound int
+
+const (
+	// EndInclusive is the last nanosecond of the period, such as 23:59:59.999999999 for a day, like EndOfDay.
+	EndInclusive EndBound = iota
+	// EndExclusive is the start of the next period, such as 00:00 of the next day, for half-open ranges like DayOf.
+	EndExclusive
+)
+
+// String returns the name of the EndBound.
+func (b EndBound) String() string {
+	switch b {
+	case EndInclusive:
+		return "Inclusive"
+	case EndExclusive:
+		return "Exclusive"
+	default:
+		return fmt.Sprintf("EndBound(%d)", int(b))
+	}
+}
+
+// StartOf returns the start of the unit containing the GDateTime on its wall clock, such as the first of the month
+// at midnight for MONTHS. WEEKS start on Monday, QUARTERS and HALF_YEARS on January 1, and DECADES and CENTURIES
+// at the years ending in 0 and 00, so the century of 2024 starts in 2000. It is FloorTo(1, unit).
+// If the unit is not recognized, the original GDateTime is returned.
+func (gdt *GDateTime) StartOf(unit timeunit.TimeUnit) *GDateTime {
+	return gdt.TruncateTo(unit)
+}
+
+// EndOf returns the last nanosecond of the unit containing the GDateTime, as StartOf.
+// If the unit is not recognized, the original GDateTime is returned.
+func (gdt *GDateTime) EndOf(unit timeunit.TimeUnit) *GDateTime {
+	return gdt.EndOfWith(unit, EndInclusive)
+}
+
+// EndOfWith returns the end of the unit containing the GDateTime with the bound: its last nanosecond,
+// or the start of the next unit. If the unit or the bound is not recognized, the original GDateTime is returned.
+func (gdt *GDateTime) EndOfWith(unit timeunit.TimeUnit, bound EndBound) *GDateTime {
+	_, next, _, ok := wallBoundaries(wallClock(gdt.t), 1, unit)
+	if !ok {
+		return gdt
+	}
+	// the last nanosecond keeps the offset of the GDateTime in a repeated hour, the next instant follows it
+	// even when the wall clock of the next start is skipped or repeated
+	last := wallToLocation(next.Add(-time.Nanosecond), gdt.t)
+	switch bound {
+	case EndExclusive:
+		return Create(last.Add(time.Nanosecond))
+	case EndInclusive:
+		return Create(last)
+	default:
+		return gdt
+	}
+}
+
+// GetQuarter returns the quarter of the year of the GDateTime, 1 to 4.
+func (gdt *GDateTime) GetQuarter() int {
+	return (int(gdt.t.Month())-1)/3 + 1
+}
+
+// StartOfMinute returns the start of the minute of the GDateTime.
+func (gdt *GDateTime) StartOfMinute() *GDateTime {
+	return gdt.StartOf(timeunit.MINUTES)
+}
+
+// EndOfMinute returns the last nanosecond of the minute of the GDateTime.
+func (gdt *GDateTime) EndOfMinute() *GDateTime {
+	return gdt.EndOf(timeunit.MINUTES)
+}
+
+// StartOfHour returns the start of the hour of the GDateTime on its wall clock, also in a zone with a 30 minute offset.
+func (gdt *GDateTime) StartOfHour() *GDateTime {
+	return gdt.StartOf(timeunit.HOURS)
+}
+
+// EndOfHour returns the last nanosecond of the hour of the GDateTime.
+func (gdt *GDateTime) EndOfHour() *GDateTime {
+	return gdt.EndOf(timeunit.HOURS)
+}
+
+// StartOfQuarter returns the first day of the quarter of the GDateTime at midnight: January, April, July or October 1.
+func (gdt *GDateTime) StartOfQuarter() *GDateTime {
+	return gdt.StartOf(timeunit.QUARTERS)
+}
+
+// EndOfQuarter returns the last nanosecond of the quarter of the GDateTime.
+func (gdt *GDateTime) EndOfQuarter() *GDateTime {
+	return gdt.EndOf(timeunit.QUARTERS)
+}
+
+// StartOfHalfYear returns the first day of the half year of the GDateTime at midnight: January 1 or July 1.
+func (gdt *GDateTime) StartOfHalfYear() *GDateTime {
+	return gdt.StartOf(timeunit.HALF_YEARS)
+}
+
+// EndOfHalfYear returns the last nanosecond of the half year of the GDateTime.
+func (gdt *GDateTime) EndOfHalfYear() *GDateTime {
+	return gdt.EndOf(timeunit.HALF_YEARS)
+}
+
+// StartOfYear returns January 1 of the year of the GDateTime at midnight.
+func (gdt *GDateTime) StartOfYear() *GDateTime {
+	return gdt.StartOf(timeunit.YEARS)
+}
+
+// EndOfYear returns the last nanosecond of the year of the GDateTime.
+func (gdt *GDateTime) EndOfYear() *GDateTime {
+	return gdt.EndOf(timeunit.YEARS)
+}
+
+// StartOfDecade returns January 1 of the decade of the GDateTime at midnight, such as 2020-01-01 for 2024.
+func (gdt *GDateTime) StartOfDecade() *GDateTime {
+	return gdt.StartOf(timeunit.DECADES)
+}
+
+// EndOfDecade returns the last nanosecond of the decade of the GDateTime, such as the end of 2029 for 2024.
+func (gdt *GDateTime) EndOfDecade() *GDateTime {
+	return gdt.EndOf(timeunit.DECADES)
+}
+
+// StartOfCentury returns January 1 of the century of the GDateTime at midnight, such as 2000-01-01 for 2024.
+func (gdt *GDateTime) StartOfCentury() *GDateTime {
+	return gdt.StartOf(timeunit.CENTURIES)
+}
+
+// EndOfCentury returns the last nanosecond of the century of the GDateTime, such as the end of 2099 for 2024.
+func (gdt *GDateTime) EndOfCentury() *GDateTime {
+	return gdt.EndOf(timeunit.CENTURIES)
+}
@@ -0,0 +1,98 @@
+package gdatetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/linsongze/go-date-time/datetime/timeunit"
+)
+
+func TestStartOfAndEndOf(t *testing.T) {
+	gdt := Create(time.Date(2024, 8, 15, 14, 35, 45, 100, time.UTC))
+	endOf := func(year int, month time.Month, day, hour, minute, second int) time.Time {
+		return time.Date(year, month, day, hour, minute, second, 999999999, time.UTC)
+	}
+	tests := []struct {
+		unit       timeunit.TimeUnit
+		start, end time.Time
+	}{
+		{timeunit.MINUTES, time.Date(2024, 8, 15, 14, 35, 0, 0, time.UTC), endOf(2024, 8, 15, 14, 35, 59)},
+		{timeunit.HOURS, time.Date(2024, 8, 15, 14, 0, 0, 0, time.UTC), endOf(2024, 8, 15, 14, 59, 59)},
+		{timeunit.DAYS, time.Date(2024, 8, 15, 0, 0, 0, 0, time.UTC), endOf(2024, 8, 15, 23, 59, 59)},
+		{timeunit.WEEKS, time.Date(2024, 8, 12, 0, 0, 0, 0, time.UTC), endOf(2024, 8, 18, 23, 59, 59)},
+		{timeunit.MONTHS, time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC), endOf(2024, 8, 31, 23, 59, 59)},
+		{timeunit.QUARTERS, time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC), endOf(2024, 9, 30, 23, 59, 59)},
+		{timeunit.HALF_YEARS, time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC), endOf(2024, 12, 31, 23, 59, 59)},
+		{timeunit.YEARS, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), endOf(2024, 12, 31, 23, 59, 59)},
+		{timeunit.DECADES, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), endOf(2029, 12, 31, 23, 59, 59)},
+		{timeunit.CENTURIES, time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), endOf(2099, 12, 31, 23, 59, 59)},
+	}
+	for _, tt := range tests {
+		if got := gdt.StartOf(tt.unit); !got.t.Equal(tt.start) {
+			t.Errorf("StartOf(%d) = %v, want %v", tt.unit, got.t, tt.start)
+		}
+		if got := gdt.EndOf(tt.unit); !got.t.Equal(tt.end) {
+			t.Errorf("EndOf(%d) = %v, want %v", tt.unit, got.t, tt.end)
+		}
+		if got := gdt.EndOfWith(tt.unit, EndExclusive); !got.t.Equal(tt.end.Add(time.Nanosecond)) {
+			t.Errorf("EndOfWith(%d, EndExclusive) = %v, want %v", tt.unit, got.t, tt.end.Add(time.Nanosecond))
+		}
+	}
+
+	named := []struct {
+		name      string
+		got, want *GDateTime
+	}{
+		{"StartOfMinute", gdt.StartOfMinute(), gdt.StartOf(timeunit.MINUTES)},
+		{"EndOfMinute", gdt.EndOfMinute(), gdt.EndOf(timeunit.MINUTES)},
+		{"StartOfHour", gdt.StartOfHour(), gdt.StartOf(timeunit.HOURS)},
+		{"EndOfHour", gdt.EndOfHour(), gdt.EndOf(timeunit.HOURS)},
+		{"StartOfQuarter", gdt.StartOfQuarter(), gdt.StartOf(timeunit.QUARTERS)},
+		{"EndOfQuarter", gdt.EndOfQuarter(), gdt.EndOf(timeunit.QUARTERS)},
+		{"StartOfHalfYear", gdt.StartOfHalfYear(), gdt.StartOf(timeunit.HALF_YEARS)},
+		{"EndOfHalfYear", gdt.EndOfHalfYear(), gdt.EndOf(timeunit.HALF_YEARS)},
+		{"StartOfYear", gdt.StartOfYear(), gdt.StartOf(timeunit.YEARS)},
+		{"EndOfYear", gdt.EndOfYear(), gdt.EndOf(timeunit.YEARS)},
+		{"StartOfDecade", gdt.StartOfDecade(), gdt.StartOf(timeunit.DECADES)},
+		{"EndOfDecade", gdt.EndOfDecade(), gdt.EndOf(timeunit.DECADES)},
+		{"StartOfCentury", gdt.StartOfCentury(), gdt.StartOf(timeunit.CENTURIES)},
+		{"EndOfCentury", gdt.EndOfCentury(), gdt.EndOf(timeunit.CENTURIES)},
+		{"EndOfMonth", gdt.EndOfMonth(), gdt.EndOf(timeunit.MONTHS)},
+		{"EndOfDay", gdt.EndOfDay(), gdt.EndOf(timeunit.DAYS)},
+	}
+	for _, n := range named {
+		if !n.got.t.Equal(n.want.t) {
+			t.Errorf("%s = %v, want %v", n.name, n.got.t, n.want.t)
+		}
+	}
+
+	if gdt.StartOf(timeunit.TimeUnit(99)) != gdt || gdt.EndOf(timeunit.TimeUnit(99)) != gdt || gdt.EndOfWith(timeunit.DAYS, EndBound(9)) != gdt {
+		t.Error("an unknown unit or bound should return the GDateTime")
+	}
+}
+
+func TestGetQuarter(t *testing.T) {
+	for month, quarter := range map[time.Month]int{time.January: 1, time.March: 1, time.April: 2, time.September: 3, time.December: 4} {
+		if got := Create(time.Date(2024, month, 10, 0, 0, 0, 0, time.UTC)).GetQuarter(); got != quarter {
+			t.Errorf("GetQuarter in %v = %d, want %d", month, got, quarter)
+		}
+	}
+}
+
+func TestEndOfAcrossDST(t *testing.T) {
+	ny := loadNewYork(t)
+	// the first 01:30 of 2024-11-03 is in EDT, its hour ends when the second 01:00, in EST, starts
+	first := Create(time.Date(2024, 11, 3, 5, 30, 0, 0, time.UTC).In(ny))
+	if got, want := first.EndOfWith(timeunit.HOURS, EndExclusive), time.Date(2024, 11, 3, 6, 0, 0, 0, time.UTC); !got.t.Equal(want) {
+		t.Errorf("exclusive end of the EDT hour = %v, want %v", got.t, want)
+	}
+	second := Create(time.Date(2024, 11, 3, 6, 30, 0, 0, time.UTC).In(ny))
+	if got, want := second.StartOfHour(), time.Date(2024, 11, 3, 6, 0, 0, 0, time.UTC); !got.t.Equal(want) {
+		t.Errorf("start of the EST hour = %v, want %v", got.t, want)
+	}
+	// 2024-03-10 lasts 23 hours
+	day := Create(time.Date(2024, 3, 10, 12, 0, 0, 0, ny))
+	if got := day.EndOfWith(timeunit.DAYS, EndExclusive).t.Sub(day.StartOf(timeunit.DAYS).t); got != 23*time.Hour {
+		t.Errorf("the day of the spring forward lasts %v, want 23h", got)
+	}
+}
@@ -0,0 +1,225 @@
+package gdatetime
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/linsongze/go-date-time/datetime/timeconst"
+)
+
+// Duration is an ISO 8601 duration such as "P1DT2H30M", made of a nominal date-based Period and an exact time part.
+// The time part is kept as seconds and nanoseconds, so it does not overflow like time.Duration.
+type Duration struct {
+	Period  Period
+	Seconds int64
+	Nanos   int // same sign as Seconds, within -999999999..999999999
+}
+
+// durationLayout describes the accepted duration notation in a ParseError.
+const durationLayout = "PnYnMnWnDTnHnMnS"
+
+var durationPattern = regexp.MustCompile(`^([-+]?)P(?:([-+]?[0-9]+)Y)?(?:([-+]?[0-9]+)M)?(?:([-+]?[0-9]+)W)?(?:([-+]?[0-9]+)D)?(?:T(?:([-+]?[0-9]+)H)?(?:([-+]?[0-9]+)M)?(?:([-+]?[0-9]+)(?:[.,]([0-9]{1,9}))?S)?)?$`)
+
+// DurationOf Obtains a Duration from a Period and an exact time.Duration
+func DurationOf(p Period, d time.Duration) Duration {
+	return DurationOfSeconds(p, 0, int64(d))
+}
+
+// DurationOfSeconds Obtains a Duration from a Period, seconds and a nanosecond adjustment
+func DurationOfSeconds(p Period, seconds int64, nanos int64) Duration {
+	seconds += nanos / timeconst.NANO_PER_SECOND
+	nanos %= timeconst.NANO_PER_SECOND
+	if seconds > 0 && nanos < 0 {
+		seconds--
+		nanos += timeconst.NANO_PER_SECOND
+	} else if seconds < 0 && nanos > 0 {
+		seconds++
+		nanos -= timeconst.NANO_PER_SECOND
+	}
+	return Duration{Period: p, Seconds: seconds, Nanos: int(nanos)}
+}
+
+// ParseDuration parses an ISO 8601 duration such as "P1DT2H30M", "PT36H", "P1M" or "-PT0.5S".
+func ParseDuration(s string) (Duration, error) {
+	match := durationPattern.FindStringSubmatch(strings.ToUpper(s))
+	if match == nil || strings.HasSuffix(strings.ToUpper(s), "T") {
+		return Duration{}, &ParseError{Input: s, Layout: durationLayout, Offset: -1, Reason: "invalid ISO 8601 duration"}
+	}
+	empty := true
+	for _, part := range match[2:9] {
+		if part != "" {
+			empty = false
+		}
+	}
+	if empty {
+		return Duration{}, &ParseError{Input: s, Layout: durationLayout, Offset: -1, Reason: "invalid ISO 8601 duration"}
+	}
+
+	values := make([]int64, 7)
+	for i, part := range match[2:9] {
+		if part == "" {
+			continue
+		}
+		v, err := strconv.ParseInt(part, 10, 64)
+		if err != nil {
+			return Duration{}, &ParseError{Input: s, Layout: durationLayout, Offset: -1, Reason: "invalid ISO 8601 duration", Err: err}
+		}
+		values[i] = v
+	}
+	var nanos int64
+	if fraction := match[9]; fraction != "" {
+		nanos, _ = strconv.ParseInt(fraction+strings.Repeat("0", 9-len(fraction)), 10, 64)
+		if strings.HasPrefix(match[8], "-") {
+			nanos = -nanos
+		}
+	}
+
+	// weeks and days are added up as days, hours, minutes and seconds as seconds
+	var days, seconds int64
+	ok := true
+	for i, part := range []struct {
+		total  *int64
+		factor int64
+	}{{&days, 7}, {&days, 1}, {&seconds, timeconst.SECONDS_PER_HOUR}, {&seconds, timeconst.SECONDS_PER_MINUTE}, {&seconds, 1}} {
+		var value int64
+		if value, ok = multiplyExact(values[i+2], part.factor); !ok {
+			break
+		}
+		if *part.total, ok = addExact(*part.total, value); !ok {
+			break
+		}
+	}
+	if !ok {
+		err := fmt.Errorf("%w: duration out of range", ErrFieldRange)
+		return Duration{}, &ParseError{Input: s, Layout: durationLayout, Offset: -1, Reason: err.Error(), Err: err}
+	}
+	p := Period{Years: int(values[0]), Months: int(values[1]), Days: int(days)}
+	d := DurationOfSeconds(p, seconds, nanos)
+	if match[1] == "-" {
+		d = d.Negated()
+	}
+	return d, nil
+}
+
+// IsZero checks if both the Period and the time part of the Duration are zero.
+func (d Duration) IsZero() bool {
+	return d.Period.IsZero() && d.Seconds == 0 && d.Nanos == 0
+}
+
+// Negated returns a copy of the Duration with each part negated.
+func (d Duration) Negated() Duration {
+	return Duration{Period: d.Period.Negated(), Seconds: -d.Seconds, Nanos: -d.Nanos}
+}
+
+// Plus returns a copy of the Duration with the other Duration added, part by part.
+func (d Duration) Plus(other Duration) Duration {
+	return DurationOfSeconds(d.Period.Plus(other.Period), d.Seconds+other.Seconds, int64(d.Nanos+other.Nanos))
+}
+
+// Minus returns a copy of the Duration with the other Duration subtracted, part by part.
+func (d Duration) Minus(other Duration) Duration {
+	return d.Plus(other.Negated())
+}
+
+// String formats the Duration in ISO 8601 notation, such as "P1DT2H30M". A zero Duration is "PT0S".
+func (d Duration) String() string {
+	if d.IsZero() {
+		return "PT0S"
+	}
+	var builder strings.Builder
+	if d.Period.IsZero() {
+		builder.WriteByte('P')
+	} else {
+		builder.WriteString(d.Period.String())
+	}
+	if d.Seconds == 0 && d.Nanos == 0 {
+		return builder.String()
+	}
+
+	builder.WriteByte('T')
+	hours := d.Seconds / timeconst.SECONDS_PER_HOUR
+	minutes := d.Seconds % timeconst.SECONDS_PER_HOUR / timeconst.SECONDS_PER_MINUTE
+	seconds := d.Seconds % timeconst.SECONDS_PER_MINUTE
+	if hours != 0 {
+		builder.WriteString(strconv.FormatInt(hours, 10))
+		builder.WriteByte('H')
+	}
+	if minutes != 0 {
+		builder.WriteString(strconv.FormatInt(minutes, 10))
+		builder.WriteByte('M')
+	}
+	if seconds != 0 || d.Nanos != 0 {
+		if seconds == 0 && d.Nanos < 0 {
+			builder.WriteByte('-')
+		}
+		builder.WriteString(strconv.FormatInt(seconds, 10))
+		if d.Nanos != 0 {
+			nanos := d.Nanos
+			if nanos < 0 {
+				nanos = -nanos
+			}
+			builder.WriteByte('.')
+			builder.WriteString(strings.TrimRight(fmt.Sprintf("%09d", nanos), "0"))
+		}
+		builder.WriteByte('S')
+	}
+	return builder.String()
+}
+
+// MarshalText implements encoding.TextMarshaler using the ISO 8601 notation.
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the ISO 8601 notation.
+func (d *Duration) UnmarshalText(text []byte) error {
+	if d == nil {
+		return errors.New("UnmarshalText on nil pointer")
+	}
+	parsed, err := ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// PlusDuration adds the Duration to the GDateTime, returning a new GDateTime instance.
+// The Period is applied first on the wall clock, so a day is "same time next day" even across DST changes,
+// then the time part is added as an exact amount of elapsed time.
+func (gdt *GDateTime) PlusDuration(d Duration) *GDateTime {
+	t := gdt.PlusPeriod(d.Period).t
+	newTime := time.Unix(t.Unix()+d.Seconds, int64(t.Nanosecond())+int64(d.Nanos)).In(t.Location())
+	return Create(newTime)
+}
+
+// MinusDuration subtracts the Duration from the GDateTime, returning a new GDateTime instance.
+func (gdt *GDateTime) MinusDuration(d Duration) *GDateTime {
+	return gdt.PlusDuration(d.Negated())
+}
+
+// multiplyExact returns a*b, false if it overflows an int64.
+func multiplyExact(a, b int64) (int64, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	product := a * b
+	if product/b != a || (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) {
+		return 0, false
+	}
+	return product, true
+}
+
+// addExact returns a+b, false if it overflows an int64.
+func addExact(a, b int64) (int64, bool) {
+	sum := a + b
+	if (a > 0 && b > 0 && sum < 0) || (a < 0 && b < 0 && sum >= 0) {
+		return 0, false
+	}
+	return sum, true
+}
@@ -0,0 +1,140 @@
+package gdatetime
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseDuration(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected Duration
+	}{
+		{"P1DT2H30M", Duration{Period: Period{Days: 1}, Seconds: 9000}},
+		{"PT36H", Duration{Seconds: 36 * 3600}},
+		{"P1M", Duration{Period: Period{Months: 1}}},
+		{"P1Y2M3W4DT5H6M7.5S", Duration{Period: Period{1, 2, 25}, Seconds: 5*3600 + 6*60 + 7, Nanos: 500000000}},
+		{"-PT1H30M", Duration{Seconds: -5400}},
+		{"PT-0.25S", Duration{Nanos: -250000000}},
+		{"PT0,001S", Duration{Nanos: 1000000}},
+		{"PT0S", Duration{}},
+		{"PT2562047H", Duration{Seconds: 2562047 * 3600}},
+	}
+
+	for _, c := range cases {
+		got, err := ParseDuration(c.input)
+		if err != nil {
+			t.Errorf("ParseDuration(%q) returned error: %v", c.input, err)
+			continue
+		}
+		if got != c.expected {
+			t.Errorf("ParseDuration(%q) failed, expected %+v, got %+v", c.input, c.expected, got)
+		}
+	}
+
+	for _, input := range []string{"", "P", "PT", "P1DT", "T1H", "PT1.5M", "P1H"} {
+		if _, err := ParseDuration(input); err == nil {
+			t.Errorf("ParseDuration(%q) should fail", input)
+		}
+	}
+
+	for _, input := range []string{"PT9999999999999999H", "P3000000000000000000W", "PT153722867280912931M", "P1200000000000000000W1000000000000000000D"} {
+		if _, err := ParseDuration(input); !errors.Is(err, ErrParse) || !errors.Is(err, ErrFieldRange) {
+			t.Errorf("ParseDuration(%q) should fail with ErrParse and ErrFieldRange, got %v", input, err)
+		}
+	}
+}
+
+func TestDurationString(t *testing.T) {
+	cases := []struct {
+		duration Duration
+		expected string
+	}{
+		{Duration{}, "PT0S"},
+		{DurationOf(Period{Days: 1}, 2*time.Hour+30*time.Minute), "P1DT2H30M"},
+		{DurationOf(Period{}, 36*time.Hour), "PT36H"},
+		{DurationOf(Period{Months: 1}, 0), "P1M"},
+		{DurationOf(Period{}, 1500*time.Millisecond), "PT1.5S"},
+		{DurationOf(Period{}, -90*time.Minute), "PT-1H-30M"},
+		{DurationOf(Period{}, -500*time.Millisecond), "PT-0.5S"},
+	}
+
+	for _, c := range cases {
+		if got := c.duration.String(); got != c.expected {
+			t.Errorf("String() failed, expected %q, got %q", c.expected, got)
+		}
+		parsed, err := ParseDuration(c.expected)
+		if err != nil || parsed != c.duration {
+			t.Errorf("ParseDuration(%q) does not round trip, got %+v, %v", c.expected, parsed, err)
+		}
+	}
+}
+
+func TestDurationOfSeconds(t *testing.T) {
+	d := DurationOfSeconds(Period{}, 1, -1)
+	if d.Seconds != 0 || d.Nanos != 999999999 {
+		t.Errorf("DurationOfSeconds failed, got %+v", d)
+	}
+	d = DurationOfSeconds(Period{}, -1, 2500000000)
+	if d.Seconds != 1 || d.Nanos != 500000000 {
+		t.Errorf("DurationOfSeconds failed, got %+v", d)
+	}
+	if sum := d.Plus(d.Negated()); !sum.IsZero() {
+		t.Errorf("Plus(Negated) should be zero, got %+v", sum)
+	}
+}
+
+func TestPlusDurationAcrossDST(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("America/New_York not available")
+	}
+	gdt := Create(time.Date(2024, 3, 9, 12, 0, 0, 0, newYork))
+
+	oneDay, _ := ParseDuration("P1D")
+	expected := time.Date(2024, 3, 10, 12, 0, 0, 0, newYork)
+	if got := gdt.PlusDuration(oneDay); !got.t.Equal(expected) {
+		t.Errorf("PlusDuration(P1D) failed, expected %v, got %v", expected, got.t)
+	}
+
+	hours, _ := ParseDuration("PT24H")
+	expected = time.Date(2024, 3, 10, 13, 0, 0, 0, newYork)
+	if got := gdt.PlusDuration(hours); !got.t.Equal(expected) {
+		t.Errorf("PlusDuration(PT24H) failed, expected %v, got %v", expected, got.t)
+	}
+
+	if got := gdt.PlusDuration(hours).MinusDuration(hours); !got.t.Equal(gdt.t) {
+		t.Errorf("MinusDuration failed, expected %v, got %v", gdt.t, got.t)
+	}
+}
+
+func TestPlusDurationBeyondTimeDuration(t *testing.T) {
+	gdt := Create(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC))
+	d, _ := ParseDuration("PT4383000H") // 182625 days, about 500 years
+	expected := time.Date(2000, 1, 1+182625, 0, 0, 0, 0, time.UTC)
+	if got := gdt.PlusDuration(d); !got.t.Equal(expected) {
+		t.Errorf("PlusDuration failed, expected %v, got %v", expected, got.t)
+	}
+}
+
+func TestDurationJSON(t *testing.T) {
+	data, err := json.Marshal(map[string]Duration{"timeout": DurationOf(Period{Days: 1}, 90*time.Minute)})
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+	if string(data) != `{"timeout":"P1DT1H30M"}` {
+		t.Errorf("Marshal failed, got %s", data)
+	}
+	var decoded map[string]Duration
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal returned error: %v", err)
+	}
+	if decoded["timeout"] != DurationOf(Period{Days: 1}, 90*time.Minute) {
+		t.Errorf("Unmarshal failed, got %+v", decoded["timeout"])
+	}
+	if err := json.Unmarshal([]byte(`{"timeout":"1h"}`), &decoded); err == nil {
+		t.Errorf("Unmarshal should fail on a non ISO 8601 value")
+	}
+}
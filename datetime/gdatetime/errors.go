@@ -0,0 +1,97 @@
+package gdatetime
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/linsongze/go-date-time/datetime/chronofield"
+)
+
+var (
+	// ErrFieldRange is matched by errors.Is for every *FieldRangeError.
+	ErrFieldRange = errors.New("gdatetime: field value out of range")
+	// ErrParse is matched by errors.Is for every *ParseError.
+	ErrParse = errors.New("gdatetime: cannot parse")
+	// ErrUnsupportedField is returned when a field cannot be queried or set.
+	ErrUnsupportedField = errors.New("gdatetime: unsupported field")
+	// ErrNilLocation is returned when a nil *time.Location is given.
+	ErrNilLocation = errors.New("gdatetime: location must not be nil")
+	// ErrUnknownZone is returned when a time zone name cannot be loaded.
+	ErrUnknownZone = errors.New("gdatetime: unknown time zone")
+	// ErrSkippedLocalTime is returned by DSTError when a local time falls in a DST gap and does not exist.
+	ErrSkippedLocalTime = errors.New("gdatetime: local time is skipped by a DST gap")
+	// ErrAmbiguousLocalTime is returned by DSTError when a local time falls in a DST overlap and occurs twice.
+	ErrAmbiguousLocalTime = errors.New("gdatetime: local time is ambiguous in a DST overlap")
+	// ErrInvalidInterval is returned when an interval would end before it starts or has a nil bound.
+	ErrInvalidInterval = errors.New("gdatetime: interval end is before its start")
+)
+
+// FieldRangeError reports a date-time field whose value is outside its valid range.
+type FieldRangeError struct {
+	Field chronofield.ChronoField
+	Value int
+	Min   int
+	Max   int
+}
+
+func (e *FieldRangeError) Error() string {
+	return fmt.Sprintf("invalid value for %s: %d (valid values %d - %d)", e.Field, e.Value, e.Min, e.Max)
+}
+
+// Is makes errors.Is(err, ErrFieldRange) true for a FieldRangeError.
+func (e *FieldRangeError) Is(target error) bool {
+	return target == ErrFieldRange
+}
+
+// ParseError reports a text that could not be parsed with a layout.
+// Offset is the byte offset in Input where parsing failed, or -1 if unknown.
+type ParseError struct {
+	Input  string
+	Layout string
+	Offset int
+	Reason string
+	Err    error // underlying error, may be nil
+}
+
+func (e *ParseError) Error() string {
+	if e.Offset >= 0 {
+		return fmt.Sprintf("parsing %q as %q: %s at offset %d", e.Input, e.Layout, e.Reason, e.Offset)
+	}
+	return fmt.Sprintf("parsing %q as %q: %s", e.Input, e.Layout, e.Reason)
+}
+
+// Is makes errors.Is(err, ErrParse) true for a ParseError.
+func (e *ParseError) Is(target error) bool {
+	return target == ErrParse
+}
+
+// Unwrap returns the underlying error.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// checkField returns a FieldRangeError if value is outside min..max.
+func checkField(field chronofield.ChronoField, value, min, max int) error {
+	if value < min || value > max {
+		return &FieldRangeError{Field: field, Value: value, Min: min, Max: max}
+	}
+	return nil
+}
+
+// newParseError converts an error from time.Parse into a ParseError.
+func newParseError(input, layout string, err error) *ParseError {
+	parseErr := &ParseError{Input: input, Layout: layout, Offset: -1, Reason: err.Error(), Err: err}
+	var timeErr *time.ParseError
+	if errors.As(err, &timeErr) {
+		parseErr.Reason = fmt.Sprintf("cannot parse %q as %q", timeErr.ValueElem, timeErr.LayoutElem)
+		if timeErr.Message != "" {
+			parseErr.Reason = strings.TrimPrefix(timeErr.Message, ": ")
+		}
+		if strings.HasSuffix(input, timeErr.ValueElem) {
+			parseErr.Offset = len(input) - len(timeErr.ValueElem)
+		}
+	}
+	return parseErr
+}
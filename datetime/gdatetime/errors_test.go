@@ -0,0 +1,91 @@
+package gdatetime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/linsongze/go-date-time/datetime/chronofield"
+)
+
+func TestWithFieldRangeErrors(t *testing.T) {
+	gdt := Create(time.Date(2023, 2, 10, 12, 0, 0, 0, time.UTC))
+
+	cases := []struct {
+		name  string
+		call  func() (*GDateTime, error)
+		field chronofield.ChronoField
+		max   int
+	}{
+		{"WithMonth", func() (*GDateTime, error) { return gdt.WithMonth(13) }, chronofield.MONTH_OF_YEAR, 12},
+		{"WithDayOfMonth", func() (*GDateTime, error) { return gdt.WithDayOfMonth(29) }, chronofield.DAY_OF_MONTH, 28},
+		{"WithDayOfYear", func() (*GDateTime, error) { return gdt.WithDayOfYear(366) }, chronofield.DAY_OF_YEAR, 365},
+		{"WithHour", func() (*GDateTime, error) { return gdt.WithHour(24) }, chronofield.HOUR_OF_DAY, 23},
+		{"WithMinute", func() (*GDateTime, error) { return gdt.WithMinute(60) }, chronofield.MINUTE_OF_HOUR, 59},
+		{"WithSecond", func() (*GDateTime, error) { return gdt.WithSecond(60) }, chronofield.SECOND_OF_MINUTE, 59},
+		{"WithNano", func() (*GDateTime, error) { return gdt.WithNano(-1) }, chronofield.NANO_OF_SECOND, 999999999},
+		{"PlusMonthsWith", func() (*GDateTime, error) {
+			return Create(time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC)).PlusMonthsWith(1, OverflowError)
+		}, chronofield.DAY_OF_MONTH, 28},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.call()
+			if !errors.Is(err, ErrFieldRange) {
+				t.Fatalf("%s should return ErrFieldRange, got %v", tc.name, err)
+			}
+			var rangeErr *FieldRangeError
+			if !errors.As(err, &rangeErr) {
+				t.Fatalf("%s should return a *FieldRangeError, got %T", tc.name, err)
+			}
+			if rangeErr.Field != tc.field || rangeErr.Max != tc.max {
+				t.Errorf("%s failed, expected field %v max %d, got %+v", tc.name, tc.field, tc.max, rangeErr)
+			}
+		})
+	}
+}
+
+func TestFieldRangeErrorMessage(t *testing.T) {
+	_, err := Create(time.Date(2023, 2, 10, 12, 0, 0, 0, time.UTC)).WithHour(24)
+	expected := "invalid value for HourOfDay: 24 (valid values 0 - 23)"
+	if err == nil || err.Error() != expected {
+		t.Errorf("expected %q, got %v", expected, err)
+	}
+}
+
+func TestParseError(t *testing.T) {
+	_, err := Parse("2023-06-02X15:04:05Z", time.RFC3339)
+	if !errors.Is(err, ErrParse) {
+		t.Fatalf("Parse should return ErrParse, got %v", err)
+	}
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("Parse should return a *ParseError, got %T", err)
+	}
+	if parseErr.Input != "2023-06-02X15:04:05Z" || parseErr.Layout != time.RFC3339 || parseErr.Offset != 10 {
+		t.Errorf("unexpected ParseError %+v", parseErr)
+	}
+	var timeErr *time.ParseError
+	if !errors.As(err, &timeErr) {
+		t.Errorf("ParseError should wrap the *time.ParseError")
+	}
+
+	_, err = Parse("2023-02-30", "2006-01-02")
+	if !errors.As(err, &parseErr) || parseErr.Reason != "day out of range" {
+		t.Errorf("unexpected ParseError %+v", parseErr)
+	}
+
+	if _, err := ParsePeriod("P1H"); !errors.Is(err, ErrParse) {
+		t.Errorf("ParsePeriod should return ErrParse, got %v", err)
+	}
+	if _, err := ParseDuration("1h"); !errors.Is(err, ErrParse) {
+		t.Errorf("ParseDuration should return ErrParse, got %v", err)
+	}
+}
+
+func TestNilLocationError(t *testing.T) {
+	if _, err := OfInLocation(2024, 1, 1, 0, 0, 0, 0, nil); !errors.Is(err, ErrNilLocation) {
+		t.Errorf("OfInLocation should return ErrNilLocation, got %v", err)
+	}
+}
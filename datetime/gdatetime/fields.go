@@ -0,0 +1,215 @@
+package gdatetime
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/linsongze/go-date-time/datetime/chronofield"
+	"github.com/linsongze/go-date-time/datetime/timeconst"
+)
+
+// IsSupported checks if the field can be queried and set on a GDateTime.
+func (gdt *GDateTime) IsSupported(field chronofield.ChronoField) bool {
+	return field.IsValid()
+}
+
+// Get returns the value of the field, such as Get(chronofield.DAY_OF_YEAR).
+func (gdt *GDateTime) Get(field chronofield.ChronoField) (int, error) {
+	t := gdt.t
+	switch field {
+	case chronofield.NANO_OF_SECOND:
+		return t.Nanosecond(), nil
+	case chronofield.NANO_OF_DAY:
+		return nanoOfDay(t), nil
+	case chronofield.MICRO_OF_SECOND:
+		return t.Nanosecond() / 1000, nil
+	case chronofield.MICRO_OF_DAY:
+		return nanoOfDay(t) / 1000, nil
+	case chronofield.MILLI_OF_SECOND:
+		return t.Nanosecond() / timeconst.NANOS_PER_MILLI, nil
+	case chronofield.MILLI_OF_DAY:
+		return nanoOfDay(t) / timeconst.NANOS_PER_MILLI, nil
+	case chronofield.SECOND_OF_MINUTE:
+		return t.Second(), nil
+	case chronofield.SECOND_OF_DAY:
+		return nanoOfDay(t) / timeconst.NANOS_PER_SECOND, nil
+	case chronofield.MINUTE_OF_HOUR:
+		return t.Minute(), nil
+	case chronofield.MINUTE_OF_DAY:
+		return t.Hour()*timeconst.MINUTES_PER_HOUR + t.Minute(), nil
+	case chronofield.HOUR_OF_AMPM:
+		return t.Hour() % 12, nil
+	case chronofield.HOUR_OF_DAY:
+		return t.Hour(), nil
+	case chronofield.AMPM_OF_DAY:
+		return t.Hour() / 12, nil
+	case chronofield.DAY_OF_WEEK:
+		return int(t.Weekday()), nil
+	case chronofield.DAY_OF_MONTH:
+		return t.Day(), nil
+	case chronofield.DAY_OF_QUARTER:
+		return int(epochDay(t.Year(), int(t.Month()), t.Day())-epochDay(t.Year(), firstMonthOfQuarter(int(t.Month())), 1)) + 1, nil
+	case chronofield.DAY_OF_YEAR:
+		return t.YearDay(), nil
+	case chronofield.EPOCH_DAY:
+		return int(epochDay(t.Year(), int(t.Month()), t.Day())), nil
+	case chronofield.ALIGNED_WEEK_OF_MONTH:
+		return (t.Day()-1)/7 + 1, nil
+	case chronofield.ALIGNED_WEEK_OF_YEAR:
+		return (t.YearDay()-1)/7 + 1, nil
+	case chronofield.ISO_WEEK:
+		_, week := t.ISOWeek()
+		return week, nil
+	case chronofield.ISO_WEEK_BASED_YEAR:
+		year, _ := t.ISOWeek()
+		return year, nil
+	case chronofield.MONTH_OF_YEAR:
+		return int(t.Month()), nil
+	case chronofield.QUARTER_OF_YEAR:
+		return (int(t.Month())-1)/3 + 1, nil
+	case chronofield.PROLEPTIC_MONTH:
+		return t.Year()*12 + int(t.Month()) - 1, nil
+	case chronofield.YEAR:
+		return t.Year(), nil
+	case chronofield.INSTANT_SECONDS:
+		return int(t.Unix()), nil
+	case chronofield.OFFSET_SECONDS:
+		_, offset := t.Zone()
+		return offset, nil
+	default:
+		return 0, fmt.Errorf("%w: %v", ErrUnsupportedField, field)
+	}
+}
+
+// Range returns the range of valid values of the field for this GDateTime,
+// such as 1 - 28 for DAY_OF_MONTH in February of a non-leap year.
+func (gdt *GDateTime) Range(field chronofield.ChronoField) (chronofield.ValueRange, error) {
+	if !gdt.IsSupported(field) {
+		return chronofield.ValueRange{}, fmt.Errorf("%w: %v", ErrUnsupportedField, field)
+	}
+	year, month := gdt.t.Year(), int(gdt.t.Month())
+	switch field {
+	case chronofield.DAY_OF_MONTH:
+		return chronofield.ValueRange{Min: 1, Max: DaysInMonth(year, month)}, nil
+	case chronofield.DAY_OF_QUARTER:
+		first := firstMonthOfQuarter(month)
+		days := DaysInMonth(year, first) + DaysInMonth(year, first+1) + DaysInMonth(year, first+2)
+		return chronofield.ValueRange{Min: 1, Max: days}, nil
+	case chronofield.DAY_OF_YEAR:
+		return chronofield.ValueRange{Min: 1, Max: daysInYear(year)}, nil
+	case chronofield.ALIGNED_WEEK_OF_MONTH:
+		return chronofield.ValueRange{Min: 1, Max: (DaysInMonth(year, month) + 6) / 7}, nil
+	case chronofield.ISO_WEEK:
+		isoYear, _ := gdt.t.ISOWeek()
+		return chronofield.ValueRange{Min: 1, Max: ISOWeeksInYear(isoYear)}, nil
+	default:
+		return field.Range(), nil
+	}
+}
+
+// With returns a copy of this GDateTime with the field set to the value, such as With(chronofield.HOUR_OF_DAY, 9).
+// The value is checked against Range(field) and a *FieldRangeError is returned if it is invalid.
+// Changing a date field keeps the time of day, and a day that does not exist in the resulting month is clamped.
+func (gdt *GDateTime) With(field chronofield.ChronoField, value int) (*GDateTime, error) {
+	valueRange, err := gdt.Range(field)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkField(field, value, valueRange.Min, valueRange.Max); err != nil {
+		return nil, err
+	}
+	current, _ := gdt.Get(field)
+	t := gdt.t
+	switch field {
+	case chronofield.NANO_OF_SECOND:
+		return gdt.WithNano(value)
+	case chronofield.NANO_OF_DAY:
+		return gdt.withNanoOfDay(value), nil
+	case chronofield.MICRO_OF_SECOND:
+		return gdt.WithNano(value * 1000)
+	case chronofield.MICRO_OF_DAY:
+		return gdt.withNanoOfDay(value * 1000), nil
+	case chronofield.MILLI_OF_SECOND:
+		return gdt.WithNano(value * timeconst.NANOS_PER_MILLI)
+	case chronofield.MILLI_OF_DAY:
+		return gdt.withNanoOfDay(value * timeconst.NANOS_PER_MILLI), nil
+	case chronofield.SECOND_OF_MINUTE:
+		return gdt.WithSecond(value)
+	case chronofield.SECOND_OF_DAY:
+		return gdt.withNanoOfDay(value*timeconst.NANOS_PER_SECOND + t.Nanosecond()), nil
+	case chronofield.MINUTE_OF_HOUR:
+		return gdt.WithMinute(value)
+	case chronofield.MINUTE_OF_DAY:
+		return gdt.withNanoOfDay(value*timeconst.NANOS_PER_MINUTE + t.Second()*timeconst.NANOS_PER_SECOND + t.Nanosecond()), nil
+	case chronofield.HOUR_OF_AMPM:
+		return gdt.WithHour(t.Hour()/12*12 + value)
+	case chronofield.HOUR_OF_DAY:
+		return gdt.WithHour(value)
+	case chronofield.AMPM_OF_DAY:
+		return gdt.WithHour(value*12 + t.Hour()%12)
+	case chronofield.DAY_OF_WEEK, chronofield.DAY_OF_QUARTER, chronofield.EPOCH_DAY:
+		return gdt.PlusDays(value - current), nil
+	case chronofield.DAY_OF_MONTH:
+		return gdt.WithDayOfMonth(value)
+	case chronofield.DAY_OF_YEAR:
+		return gdt.WithDayOfYear(value)
+	case chronofield.ALIGNED_WEEK_OF_MONTH, chronofield.ALIGNED_WEEK_OF_YEAR, chronofield.ISO_WEEK:
+		return gdt.PlusWeeks(value - current), nil
+	case chronofield.ISO_WEEK_BASED_YEAR:
+		_, week := t.ISOWeek()
+		if maxWeek := ISOWeeksInYear(value); week > maxWeek {
+			week = maxWeek
+		}
+		year, month, day := isoWeekDate(value, week, isoDayOfWeek(t.Weekday()))
+		newTime := time.Date(year, time.Month(month), day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+		return Create(newTime), nil
+	case chronofield.MONTH_OF_YEAR:
+		return gdt.WithMonth(value)
+	case chronofield.QUARTER_OF_YEAR:
+		return gdt.PlusMonthsWith((value-current)*3, OverflowClamp)
+	case chronofield.PROLEPTIC_MONTH:
+		return gdt.PlusMonthsWith(value-current, OverflowClamp)
+	case chronofield.YEAR:
+		return gdt.withDate(value, int(t.Month()), OverflowClamp)
+	case chronofield.INSTANT_SECONDS:
+		return Create(time.Unix(int64(value), int64(t.Nanosecond())).In(t.Location())), nil
+	case chronofield.OFFSET_SECONDS:
+		// keep the wall clock and replace the zone by a fixed offset
+		newTime := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.FixedZone("", value))
+		return Create(newTime), nil
+	default:
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedField, field)
+	}
+}
+
+// withNanoOfDay returns a copy of the GDateTime on the same date with the time of day set from nanoseconds since midnight.
+func (gdt *GDateTime) withNanoOfDay(nanos int) *GDateTime {
+	newTime := time.Date(gdt.t.Year(), gdt.t.Month(), gdt.t.Day(), 0, 0, 0, nanos, gdt.t.Location())
+	return Create(newTime)
+}
+
+// nanoOfDay returns the wall clock time of t as nanoseconds since midnight.
+func nanoOfDay(t time.Time) int {
+	return t.Hour()*timeconst.NANOS_PER_HOUR + t.Minute()*timeconst.NANOS_PER_MINUTE + t.Second()*timeconst.NANOS_PER_SECOND + t.Nanosecond()
+}
+
+// firstMonthOfQuarter returns the first month (1, 4, 7 or 10) of the quarter containing month.
+func firstMonthOfQuarter(month int) int {
+	return (month-1)/3*3 + 1
+}
+
+// isoDayOfWeek converts a time.Weekday to the ISO day of week, Monday = 1, ..., Sunday = 7.
+func isoDayOfWeek(weekday time.Weekday) int {
+	if weekday == time.Sunday {
+		return 7
+	}
+	return int(weekday)
+}
+
+// isoWeekDate returns the calendar date of the ISO week date year-Wweek-weekday, weekday being 1 (Monday) to 7.
+func isoWeekDate(year, week, weekday int) (int, int, int) {
+	jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+	monday := jan4.AddDate(0, 0, 1-isoDayOfWeek(jan4.Weekday()))
+	date := monday.AddDate(0, 0, (week-1)*7+weekday-1)
+	return date.Year(), int(date.Month()), date.Day()
+}
@@ -0,0 +1,170 @@
+package gdatetime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/linsongze/go-date-time/datetime/chronofield"
+)
+
+func TestGetField(t *testing.T) {
+	// 2024-06-03 is a Monday in ISO week 23
+	gdt := Create(time.Date(2024, 6, 3, 14, 15, 30, 123456789, time.FixedZone("", 8*3600)))
+
+	cases := []struct {
+		field    chronofield.ChronoField
+		expected int
+	}{
+		{chronofield.NANO_OF_SECOND, 123456789},
+		{chronofield.MICRO_OF_SECOND, 123456},
+		{chronofield.MILLI_OF_SECOND, 123},
+		{chronofield.SECOND_OF_MINUTE, 30},
+		{chronofield.SECOND_OF_DAY, 14*3600 + 15*60 + 30},
+		{chronofield.MILLI_OF_DAY, (14*3600+15*60+30)*1000 + 123},
+		{chronofield.MINUTE_OF_HOUR, 15},
+		{chronofield.MINUTE_OF_DAY, 14*60 + 15},
+		{chronofield.HOUR_OF_AMPM, 2},
+		{chronofield.HOUR_OF_DAY, 14},
+		{chronofield.AMPM_OF_DAY, 1},
+		{chronofield.DAY_OF_WEEK, 1},
+		{chronofield.DAY_OF_MONTH, 3},
+		{chronofield.DAY_OF_QUARTER, 30 + 31 + 3},
+		{chronofield.DAY_OF_YEAR, 155},
+		{chronofield.EPOCH_DAY, 19877},
+		{chronofield.ALIGNED_WEEK_OF_MONTH, 1},
+		{chronofield.ALIGNED_WEEK_OF_YEAR, 23},
+		{chronofield.ISO_WEEK, 23},
+		{chronofield.ISO_WEEK_BASED_YEAR, 2024},
+		{chronofield.MONTH_OF_YEAR, 6},
+		{chronofield.QUARTER_OF_YEAR, 2},
+		{chronofield.PROLEPTIC_MONTH, 2024*12 + 5},
+		{chronofield.YEAR, 2024},
+		{chronofield.INSTANT_SECONDS, int(gdt.GetSecondTimestamp())},
+		{chronofield.OFFSET_SECONDS, 8 * 3600},
+	}
+
+	for _, c := range cases {
+		got, err := gdt.Get(c.field)
+		if err != nil {
+			t.Errorf("Get(%v) returned error: %v", c.field, err)
+			continue
+		}
+		if got != c.expected {
+			t.Errorf("Get(%v) failed, expected %d, got %d", c.field, c.expected, got)
+		}
+	}
+
+	if _, err := gdt.Get(chronofield.ChronoField(99)); !errors.Is(err, ErrUnsupportedField) {
+		t.Errorf("Get on an unknown field should return ErrUnsupportedField, got %v", err)
+	}
+	if gdt.IsSupported(chronofield.ChronoField(99)) || !gdt.IsSupported(chronofield.YEAR) {
+		t.Errorf("IsSupported failed")
+	}
+}
+
+func TestRangeField(t *testing.T) {
+	cases := []struct {
+		date     time.Time
+		field    chronofield.ChronoField
+		expected chronofield.ValueRange
+	}{
+		{time.Date(2023, 2, 10, 0, 0, 0, 0, time.UTC), chronofield.DAY_OF_MONTH, chronofield.ValueRange{Min: 1, Max: 28}},
+		{time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC), chronofield.DAY_OF_MONTH, chronofield.ValueRange{Min: 1, Max: 29}},
+		{time.Date(2024, 7, 10, 0, 0, 0, 0, time.UTC), chronofield.DAY_OF_MONTH, chronofield.ValueRange{Min: 1, Max: 31}},
+		{time.Date(2023, 2, 10, 0, 0, 0, 0, time.UTC), chronofield.ALIGNED_WEEK_OF_MONTH, chronofield.ValueRange{Min: 1, Max: 4}},
+		{time.Date(2023, 5, 10, 0, 0, 0, 0, time.UTC), chronofield.DAY_OF_YEAR, chronofield.ValueRange{Min: 1, Max: 365}},
+		{time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC), chronofield.DAY_OF_YEAR, chronofield.ValueRange{Min: 1, Max: 366}},
+		{time.Date(2023, 2, 10, 0, 0, 0, 0, time.UTC), chronofield.DAY_OF_QUARTER, chronofield.ValueRange{Min: 1, Max: 90}},
+		{time.Date(2020, 6, 10, 0, 0, 0, 0, time.UTC), chronofield.ISO_WEEK, chronofield.ValueRange{Min: 1, Max: 53}},
+		{time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC), chronofield.ISO_WEEK, chronofield.ValueRange{Min: 1, Max: 52}},
+		{time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC), chronofield.HOUR_OF_DAY, chronofield.ValueRange{Min: 0, Max: 23}},
+	}
+
+	for _, c := range cases {
+		got, err := Create(c.date).Range(c.field)
+		if err != nil {
+			t.Errorf("Range(%v) returned error: %v", c.field, err)
+			continue
+		}
+		if got != c.expected {
+			t.Errorf("Range(%v) on %v failed, expected %v, got %v", c.field, c.date, c.expected, got)
+		}
+	}
+}
+
+func TestWithField(t *testing.T) {
+	gdt := Create(time.Date(2024, 1, 31, 14, 15, 30, 500, time.UTC))
+
+	cases := []struct {
+		field    chronofield.ChronoField
+		value    int
+		expected time.Time
+	}{
+		{chronofield.YEAR, 2023, time.Date(2023, 1, 31, 14, 15, 30, 500, time.UTC)},
+		{chronofield.MONTH_OF_YEAR, 2, time.Date(2024, 2, 29, 14, 15, 30, 500, time.UTC)},
+		{chronofield.QUARTER_OF_YEAR, 2, time.Date(2024, 4, 30, 14, 15, 30, 500, time.UTC)},
+		{chronofield.PROLEPTIC_MONTH, 2025*12 + 1, time.Date(2025, 2, 28, 14, 15, 30, 500, time.UTC)},
+		{chronofield.DAY_OF_MONTH, 5, time.Date(2024, 1, 5, 14, 15, 30, 500, time.UTC)},
+		{chronofield.DAY_OF_YEAR, 60, time.Date(2024, 2, 29, 14, 15, 30, 500, time.UTC)},
+		{chronofield.DAY_OF_WEEK, 0, time.Date(2024, 1, 28, 14, 15, 30, 500, time.UTC)},
+		{chronofield.EPOCH_DAY, 0, time.Date(1970, 1, 1, 14, 15, 30, 500, time.UTC)},
+		{chronofield.ISO_WEEK, 1, time.Date(2024, 1, 3, 14, 15, 30, 500, time.UTC)},
+		{chronofield.ISO_WEEK_BASED_YEAR, 2020, time.Date(2020, 1, 29, 14, 15, 30, 500, time.UTC)},
+		{chronofield.HOUR_OF_DAY, 9, time.Date(2024, 1, 31, 9, 15, 30, 500, time.UTC)},
+		{chronofield.AMPM_OF_DAY, 0, time.Date(2024, 1, 31, 2, 15, 30, 500, time.UTC)},
+		{chronofield.HOUR_OF_AMPM, 11, time.Date(2024, 1, 31, 23, 15, 30, 500, time.UTC)},
+		{chronofield.MINUTE_OF_DAY, 61, time.Date(2024, 1, 31, 1, 1, 30, 500, time.UTC)},
+		{chronofield.SECOND_OF_DAY, 3600, time.Date(2024, 1, 31, 1, 0, 0, 500, time.UTC)},
+		{chronofield.MILLI_OF_SECOND, 250, time.Date(2024, 1, 31, 14, 15, 30, 250000000, time.UTC)},
+		{chronofield.NANO_OF_DAY, 1, time.Date(2024, 1, 31, 0, 0, 0, 1, time.UTC)},
+		{chronofield.INSTANT_SECONDS, 0, time.Date(1970, 1, 1, 0, 0, 0, 500, time.UTC)},
+	}
+
+	for _, c := range cases {
+		got, err := gdt.With(c.field, c.value)
+		if err != nil {
+			t.Errorf("With(%v, %d) returned error: %v", c.field, c.value, err)
+			continue
+		}
+		if !got.t.Equal(c.expected) {
+			t.Errorf("With(%v, %d) failed, expected %v, got %v", c.field, c.value, c.expected, got.t)
+		}
+	}
+
+	withOffset, _ := gdt.With(chronofield.OFFSET_SECONDS, 3600)
+	if _, offset := withOffset.t.Zone(); offset != 3600 || withOffset.GetHour() != 14 {
+		t.Errorf("With(OFFSET_SECONDS) should keep the wall clock, got %v", withOffset.t)
+	}
+}
+
+func TestWithFieldOutOfRange(t *testing.T) {
+	gdt := Create(time.Date(2023, 2, 10, 0, 0, 0, 0, time.UTC))
+	_, err := gdt.With(chronofield.DAY_OF_MONTH, 29)
+	var rangeErr *FieldRangeError
+	if !errors.As(err, &rangeErr) || rangeErr.Field != chronofield.DAY_OF_MONTH || rangeErr.Max != 28 {
+		t.Errorf("With(DAY_OF_MONTH, 29) should fail with max 28, got %v", err)
+	}
+	if _, err := gdt.With(chronofield.ChronoField(-1), 0); !errors.Is(err, ErrUnsupportedField) {
+		t.Errorf("With on an unknown field should return ErrUnsupportedField, got %v", err)
+	}
+}
+
+func TestGetWithRoundTrip(t *testing.T) {
+	gdt := Create(time.Date(2024, 8, 17, 6, 45, 12, 987000000, time.UTC)) // MILLI_OF_SECOND drops the sub-millisecond part
+	for _, field := range chronofield.Values() {
+		value, err := gdt.Get(field)
+		if err != nil {
+			t.Errorf("Get(%v) returned error: %v", field, err)
+			continue
+		}
+		got, err := gdt.With(field, value)
+		if err != nil {
+			t.Errorf("With(%v, %d) returned error: %v", field, value, err)
+			continue
+		}
+		if !got.t.Equal(gdt.t) {
+			t.Errorf("With(%v, Get(%v)) should not change the value, got %v", field, field, got.t)
+		}
+	}
+}
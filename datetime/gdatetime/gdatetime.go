@@ -1,7 +1,7 @@
 package gdatetime
 
 import (
-	"errors"
+	"github.com/linsongze/go-date-time/datetime/chronofield"
 	"github.com/linsongze/go-date-time/datetime/timeconst"
 	"github.com/linsongze/go-date-time/datetime/timeunit"
 	"time"
@@ -21,11 +21,11 @@ func Now() *GDateTime {
 	return &GDateTime{t: time.Now()}
 }
 
-// Parse dateStr use time layout
+// Parse dateStr use time layout, a failure is returned as a *ParseError
 func Parse(dateStr string, layout string) (*GDateTime, error) {
 	t, err := time.Parse(layout, dateStr)
 	if err != nil {
-		return nil, err
+		return nil, newParseError(dateStr, layout, err)
 	}
 	return Create(t), nil
 }
@@ -72,31 +72,28 @@ func daysInYear(year int) int {
 	return 365
 }
 
+// epochDay returns the number of days from 1970-01-01 to the given date.
+func epochDay(year, month, day int) int64 {
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC).Unix() / timeconst.SECONDS_PER_DAY
+}
+
+// plusMonthsClamped adds months to the given date, clamping the day to the last valid day of the resulting month.
+func plusMonthsClamped(year, month, day, months int) (int, int, int) {
+	total := year*12 + (month - 1) + months
+	newYear, newMonth := floorDiv(total, 12), floorMod(total, 12)+1
+	day, _ = resolveDayOfMonth(year, month, day, newYear, newMonth, OverflowClamp)
+	return newYear, newMonth, day
+}
+
 // Of Obtains an instance of local zone GDateTime from year, month, day, hour, minute, second and nanosecond
+// Every field is validated strictly, including the day against the length of the month; see OfResolved for other styles.
 func Of(year, month, dayOfMonth, hour, minute, second, nanoOfSecond int) (*GDateTime, error) {
-	if year < 0 {
-		return nil, errors.New("invalid year")
-	}
-	if month < 1 || month > 12 {
-		return nil, errors.New("month must be between 1 and 12")
-	}
-	if dayOfMonth < 1 || dayOfMonth > 31 {
-		return nil, errors.New("day must be between 1 and 31")
-	}
-	if hour < 0 || hour > 23 {
-		return nil, errors.New("hour must be between 0 and 23")
-	}
-	if minute < 0 || minute > 59 {
-		return nil, errors.New("minute must be between 0 and 59")
-	}
-	if second < 0 || second > 59 {
-		return nil, errors.New("second must be between 0 and 59")
-	}
-	if nanoOfSecond < 0 || nanoOfSecond > timeconst.MAX_NANO {
-		return nil, errors.New("nanosecond must be between 0 and timeconst.MAX_NANO")
-	}
-	t := time.Date(year, time.Month(month), dayOfMonth, hour, minute, second, nanoOfSecond, time.Local)
-	return Create(t), nil
+	return OfInLocation(year, month, dayOfMonth, hour, minute, second, nanoOfSecond, time.Local)
+}
+
+// OfInLocation Obtains an instance of GDateTime in the given location from year, month, day, hour, minute, second and nanosecond
+func OfInLocation(year, month, dayOfMonth, hour, minute, second, nanoOfSecond int, loc *time.Location) (*GDateTime, error) {
+	return OfResolved(ResolverStrict, year, month, dayOfMonth, hour, minute, second, nanoOfSecond, loc)
 }
 
 // Of2 Obtains an instance of local zone GDateTime from year, month, day, hour, minute, second
@@ -170,13 +167,9 @@ func (gdt *GDateTime) GetNano() int {
 }
 
 // WeekOfYearStartsFromJan1 calculates the week number starting from January 1st, considering the week containing January 1st as the first week of the year.
+// Weeks start on Sunday, it is WeekOfYear(WeekFieldsUS).
 func (gdt *GDateTime) WeekOfYearStartsFromJan1() int {
-	yearStart := time.Date(gdt.t.Year(), time.January, 1, 0, 0, 0, 0, gdt.t.Location())
-	yearStartWeekday := yearStart.Weekday()
-
-	daysSinceYearStart := gdt.t.YearDay() - 1
-	weekOfYear := (daysSinceYearStart+int(yearStartWeekday))/7 + 1
-	return weekOfYear
+	return gdt.WeekOfYear(WeekFieldsUS)
 }
 
 // FirstFullWeekOfYear calculates the week number such that the first full week (Monday through Sunday) that entirely falls within the new year counts as the first week of the year.
@@ -196,33 +189,44 @@ func (gdt *GDateTime) FirstFullWeekOfYear() int {
 }
 
 // WeekOfYearISO8601 calculates the week number according to the ISO 8601 standard, where the week containing the first Thursday of the year is considered the first week.
+// It is WeekOfWeekBasedYear(WeekFieldsISO), use ISOWeekDate or ToYearWeek to also get the week-based year.
 func (gdt *GDateTime) WeekOfYearISO8601() int {
 	_, week := gdt.t.ISOWeek()
 	return week
 }
 
 // WithYear withYear creates a new GDateTime instance with the specified year, keeping other components the same.
+// Feb 29 is clamped to Feb 28 when the new year is not a leap year.
 func (gdt *GDateTime) WithYear(year int) (*GDateTime, error) {
-	if year < 0 {
-		return nil, errors.New("invalid year")
+	return gdt.WithYearPolicy(year, OverflowClamp)
+}
+
+// WithYearPolicy creates a new GDateTime instance with the specified year, resolving Feb 29 in a non-leap year with the policy.
+func (gdt *GDateTime) WithYearPolicy(year int, policy OverflowPolicy) (*GDateTime, error) {
+	if err := checkField(chronofield.YEAR, year, timeconst.MIN_YEAR, timeconst.MAX_YEAR); err != nil {
+		return nil, err
 	}
-	newTime := time.Date(year, gdt.t.Month(), gdt.t.Day(), gdt.t.Hour(), gdt.t.Minute(), gdt.t.Second(), gdt.t.Nanosecond(), gdt.t.Location())
-	return Create(newTime), nil
+	return gdt.withDate(year, int(gdt.t.Month()), policy)
 }
 
 // WithMonth withMonth creates a new GDateTime instance with the specified month, keeping other components the same.
+// The day is clamped to the last day of the new month if needed, so May 31 with month 2 gives Feb 28/29.
 func (gdt *GDateTime) WithMonth(month int) (*GDateTime, error) {
-	if month < 1 || month > 12 {
-		return nil, errors.New("month must be between 1 and 12")
+	return gdt.WithMonthPolicy(month, OverflowClamp)
+}
+
+// WithMonthPolicy creates a new GDateTime instance with the specified month, resolving a day that does not exist with the policy.
+func (gdt *GDateTime) WithMonthPolicy(month int, policy OverflowPolicy) (*GDateTime, error) {
+	if err := checkField(chronofield.MONTH_OF_YEAR, month, 1, 12); err != nil {
+		return nil, err
 	}
-	newTime := time.Date(gdt.t.Year(), time.Month(month), gdt.t.Day(), gdt.t.Hour(), gdt.t.Minute(), gdt.t.Second(), gdt.t.Nanosecond(), gdt.t.Location())
-	return Create(newTime), nil
+	return gdt.withDate(gdt.t.Year(), month, policy)
 }
 
 // WithDayOfMonth Returns a copy of this GDateTime with the day-of-month altered.
 func (gdt *GDateTime) WithDayOfMonth(day int) (*GDateTime, error) {
-	if day < 1 || day > DaysInMonth(gdt.t.Year(), int(gdt.t.Month())) {
-		return nil, errors.New("invalid day for the month") // invalid day for the month
+	if err := checkField(chronofield.DAY_OF_MONTH, day, 1, DaysInMonth(gdt.t.Year(), int(gdt.t.Month()))); err != nil {
+		return nil, err
 	}
 	newTime := time.Date(gdt.t.Year(), gdt.t.Month(), day, gdt.t.Hour(), gdt.t.Minute(), gdt.t.Second(), gdt.t.Nanosecond(), gdt.t.Location())
 	return Create(newTime), nil
@@ -230,8 +234,8 @@ func (gdt *GDateTime) WithDayOfMonth(day int) (*GDateTime, error) {
 
 // WithDayOfYear Returns a copy of this GDateTime with the day-of-year altered.
 func (gdt *GDateTime) WithDayOfYear(day int) (*GDateTime, error) {
-	if day < 1 || day > daysInYear(gdt.t.Year()) {
-		return nil, errors.New("invalid day for the year") // invalid day for the Year
+	if err := checkField(chronofield.DAY_OF_YEAR, day, 1, daysInYear(gdt.t.Year())); err != nil {
+		return nil, err
 	}
 	// Set the new date using the day of the year
 	newTime := time.Date(gdt.t.Year(), 1, 1, gdt.t.Hour(), gdt.t.Minute(), gdt.t.Second(), gdt.t.Nanosecond(), gdt.t.Location())
@@ -241,8 +245,8 @@ func (gdt *GDateTime) WithDayOfYear(day int) (*GDateTime, error) {
 
 // WithHour Returns a copy of this GDateTime with the hour-of-day altered.
 func (gdt *GDateTime) WithHour(hour int) (*GDateTime, error) {
-	if hour < 0 || hour > 23 {
-		return nil, errors.New("month must be between 0 and 23") //
+	if err := checkField(chronofield.HOUR_OF_DAY, hour, 0, timeconst.MAX_HOUR); err != nil {
+		return nil, err
 	}
 	newTime := time.Date(gdt.t.Year(), gdt.t.Month(), gdt.t.Day(), hour, gdt.t.Minute(), gdt.t.Second(), gdt.t.Nanosecond(), gdt.t.Location())
 	return Create(newTime), nil
@@ -250,8 +254,8 @@ func (gdt *GDateTime) WithHour(hour int) (*GDateTime, error) {
 
 // WithMinute Returns a copy of this GDateTime with the minute altered.
 func (gdt *GDateTime) WithMinute(minute int) (*GDateTime, error) {
-	if minute < 0 || minute > 59 {
-		return nil, errors.New("minute must be between 0 and 59") //
+	if err := checkField(chronofield.MINUTE_OF_HOUR, minute, 0, timeconst.MAX_MINUTE); err != nil {
+		return nil, err
 	}
 	newTime := time.Date(gdt.t.Year(), gdt.t.Month(), gdt.t.Day(), gdt.t.Hour(), minute, gdt.t.Second(), gdt.t.Nanosecond(), gdt.t.Location())
 	return Create(newTime), nil
@@ -259,8 +263,8 @@ func (gdt *GDateTime) WithMinute(minute int) (*GDateTime, error) {
 
 // WithSecond Returns a copy of this GDateTime with the second altered.
 func (gdt *GDateTime) WithSecond(second int) (*GDateTime, error) {
-	if second < 0 || second > 59 {
-		return nil, errors.New("second must be between 0 and 59") //
+	if err := checkField(chronofield.SECOND_OF_MINUTE, second, 0, timeconst.MAX_SECOND); err != nil {
+		return nil, err
 	}
 	newTime := time.Date(gdt.t.Year(), gdt.t.Month(), gdt.t.Day(), gdt.t.Hour(), gdt.t.Minute(), second, gdt.t.Nanosecond(), gdt.t.Location())
 	return Create(newTime), nil
@@ -268,38 +272,33 @@ func (gdt *GDateTime) WithSecond(second int) (*GDateTime, error) {
 
 // WithNano Returns a copy of this GDateTime with the nanosecond altered.
 func (gdt *GDateTime) WithNano(nano int) (*GDateTime, error) {
-	if nano < 0 || nano > timeconst.MAX_NANO {
-		return nil, errors.New("nanosecond must be between 0 and timeconst.MAX_NANO") //
+	if err := checkField(chronofield.NANO_OF_SECOND, nano, 0, timeconst.MAX_NANO); err != nil {
+		return nil, err
 	}
 	newTime := time.Date(gdt.t.Year(), gdt.t.Month(), gdt.t.Day(), gdt.t.Hour(), gdt.t.Minute(), gdt.t.Second(), nano, gdt.t.Location())
 	return Create(newTime), nil
 }
 
-// TruncateTo truncates the GDateTime to the specified unit.
+// TruncateTo truncates the GDateTime to the specified unit on its wall clock, such as the start of its hour,
+// which is FloorTo(1, unit). If the unit is not recognized, the original GDateTime is returned.
 func (gdt *GDateTime) TruncateTo(unit timeunit.TimeUnit) *GDateTime {
-	var d time.Duration
-	switch unit {
-	case timeunit.NANOS:
-		d = time.Nanosecond
-	case timeunit.SECONDS:
-		d = time.Second
-	case timeunit.MINUTES:
-		d = time.Minute
-	case timeunit.HOURS:
-		d = time.Hour
-	default:
-		return gdt // If the unit is not recognized, return the original GDateTime
+	truncated, err := gdt.FloorTo(1, unit)
+	if err != nil {
+		return gdt
 	}
-	return Create(gdt.t.Truncate(d))
+	return truncated
 }
 
 // PlusYears adds the specified number of years to the GDateTime, returning a new GDateTime instance.
+// Like time.AddDate, Feb 29 overflows into Mar 1 in a non-leap year; use PlusYearsWith to choose another OverflowPolicy.
 func (gdt *GDateTime) PlusYears(years int) *GDateTime {
 	newTime := gdt.t.AddDate(years, 0, 0)
 	return Create(newTime)
 }
 
 // PlusMonths  adds the specified number of months to the GDateTime, returning a new GDateTime instance.
+// Like time.AddDate, a day that does not exist overflows into the next month (Jan 31 + 1 month = Mar 2/3);
+// use PlusMonthsWith to choose another OverflowPolicy.
 func (gdt *GDateTime) PlusMonths(months int) *GDateTime {
 	newTime := gdt.t.AddDate(0, months, 0)
 	return Create(newTime)
@@ -362,6 +361,22 @@ func (gdt *GDateTime) Plus(amountToAdd int, unit timeunit.TimeUnit) *GDateTime {
 	case timeunit.HALF_DAYS:
 		// Convert half-days to days and hours
 		return gdt.PlusDays(amountToAdd / 2).PlusHours((amountToAdd % 2) * 12)
+	case timeunit.DAYS:
+		return gdt.PlusDays(amountToAdd)
+	case timeunit.WEEKS:
+		return gdt.PlusWeeks(amountToAdd)
+	case timeunit.MONTHS:
+		return gdt.PlusMonths(amountToAdd)
+	case timeunit.QUARTERS:
+		return gdt.PlusMonths(amountToAdd * 3)
+	case timeunit.HALF_YEARS:
+		return gdt.PlusMonths(amountToAdd * 6)
+	case timeunit.YEARS:
+		return gdt.PlusYears(amountToAdd)
+	case timeunit.DECADES:
+		return gdt.PlusYears(amountToAdd * 10)
+	case timeunit.CENTURIES:
+		return gdt.PlusYears(amountToAdd * 100)
 	default:
 		return gdt // Fallback, no operation if the unit is not recognized
 	}
@@ -423,46 +438,32 @@ func (gdt *GDateTime) StartOfMonth() *GDateTime {
 }
 
 // EndOfMonth returns a new GDateTime instance set to the end of the month of the original GDateTime.
+// For ranges prefer the half-open MonthOf, which ends at the start of the next month.
 func (gdt *GDateTime) EndOfMonth() *GDateTime {
 	endOfMonth := time.Date(gdt.t.Year(), gdt.t.Month(), DaysInMonth(gdt.t.Year(), int(gdt.t.Month())), 23, 59, 59, timeconst.MAX_NANO, gdt.t.Location())
 	return Create(endOfMonth)
 }
 
 // StartOfWeek returns a new GDateTime instance set to the start of the week of the original GDateTime.
+// The week starts on Sunday, use StartOfWeekWith for another WeekFields.
 func (gdt *GDateTime) StartOfWeek() *GDateTime {
-	// Weekday returns Sunday as 0
-	offset := int(gdt.t.Weekday())
-	startOfWeek := gdt.t.AddDate(0, 0, -offset) // Adjust to start of the week, assuming week starts on Sunday
-	startOfWeek = time.Date(startOfWeek.Year(), startOfWeek.Month(), startOfWeek.Day(), 0, 0, 0, 0, startOfWeek.Location())
-	return Create(startOfWeek)
+	return gdt.StartOfWeekWith(WeekFieldsUS)
 }
 
 // EndOfWeek returns a new GDateTime instance set to the end of the week of the original GDateTime.
+// The week ends on Saturday, use EndOfWeekWith for another WeekFields.
 func (gdt *GDateTime) EndOfWeek() *GDateTime {
-	offset := 6 - int(gdt.t.Weekday()) // Assuming week starts on Sunday, end on Saturday
-	endOfWeek := gdt.t.AddDate(0, 0, offset)
-	endOfWeek = time.Date(endOfWeek.Year(), endOfWeek.Month(), endOfWeek.Day(), 23, 59, 59, timeconst.MAX_NANO, endOfWeek.Location())
-	return Create(endOfWeek)
+	return gdt.EndOfWeekWith(WeekFieldsUS)
 }
 
 // StartOfWeekFromMonday returns a new GDateTime instance set to the start of the week (starting from Monday) of the original GDateTime.
 func (gdt *GDateTime) StartOfWeekFromMonday() *GDateTime {
-	// Weekday returns Sunday as 0, hence Monday as 1
-	offset := (int(gdt.t.Weekday()) + 6) % 7 // Adjust to start of the week, assuming week starts on Monday
-	startOfWeek := gdt.t.AddDate(0, 0, -offset)
-	startOfWeek = time.Date(startOfWeek.Year(), startOfWeek.Month(), startOfWeek.Day(), 0, 0, 0, 0, startOfWeek.Location())
-	return Create(startOfWeek)
+	return gdt.StartOfWeekWith(WeekFieldsISO)
 }
 
 // EndOfWeekFromMonday returns a new GDateTime instance set to the end of the week (ending on Sunday) of the original GDateTime.
 func (gdt *GDateTime) EndOfWeekFromMonday() *GDateTime {
-	offset := 7 - int(gdt.t.Weekday()) // Assuming week starts on Monday, end on Sunday
-	if offset == 7 {
-		offset = 0
-	}
-	endOfWeek := gdt.t.AddDate(0, 0, offset)
-	endOfWeek = time.Date(endOfWeek.Year(), endOfWeek.Month(), endOfWeek.Day(), 23, 59, 59, 999999999, endOfWeek.Location())
-	return Create(endOfWeek)
+	return gdt.EndOfWeekWith(WeekFieldsISO)
 }
 
 // StartOfDay returns a new GDateTime instance set to the start of the day (00:00:00) of the original GDateTime.
@@ -472,15 +473,19 @@ func (gdt *GDateTime) StartOfDay() *GDateTime {
 }
 
 // EndOfDay returns a new GDateTime instance set to the end of the day (23:59:59) of the original GDateTime.
+// For ranges prefer the half-open DayOf, which ends at the start of the next day.
 func (gdt *GDateTime) EndOfDay() *GDateTime {
 	endOfDay := time.Date(gdt.t.Year(), gdt.t.Month(), gdt.t.Day(), 23, 59, 59, timeconst.MAX_NANO, gdt.t.Location())
 	return Create(endOfDay)
 }
 
 // ConvertToZone change GDateTime zone
+// The location is taken by value, so it is mapped back to the shared location of the same name when possible.
+//
+// Deprecated: copying a time.Location copies its internal cache and loses the identity of time.UTC and time.Local.
+// Use InLocation or InZone instead.
 func (gdt *GDateTime) ConvertToZone(loc time.Location) *GDateTime {
-	t := gdt.t.In(&loc)
-	return Create(t)
+	return gdt.InLocation(canonicalLocation(&loc, gdt.t))
 }
 
 // ResetZoneToDefault reset zone to local
@@ -623,6 +628,7 @@ func (gdt *GDateTime) SecondsBetween(end *GDateTime) int {
 }
 
 // IsWithinRange checks if the GDateTime is within the range specified by start and end.
+// Both ends are inclusive, see Interval.Contains for a half-open range.
 func (gdt *GDateTime) IsWithinRange(start, end *GDateTime) bool {
 	return !gdt.t.Before(start.t) && !gdt.t.After(end.t)
 }
@@ -1,7 +1,9 @@
 package gdatetime
 
 import (
+	"errors"
 	"fmt"
+	"github.com/linsongze/go-date-time/datetime/chronofield"
 	"github.com/linsongze/go-date-time/datetime/timeconst"
 	"github.com/linsongze/go-date-time/datetime/timeunit"
 	"testing"
@@ -165,10 +167,17 @@ func TestWithYear(t *testing.T) {
 		t.Errorf("withYear failed, expected %d, got %d", 2025, updatedGdt.GetYear())
 	}
 
-	// Test invalid year
+	// Test negative year, within the range of chronofield.YEAR
 	updatedGdt, err = gdt.WithYear(-1)
-	if err == nil {
-		t.Error("withYear should fail with negative year")
+	if err != nil || updatedGdt.GetYear() != -1 {
+		t.Errorf("withYear(-1) failed, got %v, %v", updatedGdt, err)
+	}
+
+	// Test invalid year
+	_, err = gdt.WithYear(timeconst.MIN_YEAR - 1)
+	var rangeErr *FieldRangeError
+	if !errors.As(err, &rangeErr) || rangeErr.Min != chronofield.YEAR.Range().Min || rangeErr.Max != chronofield.YEAR.Range().Max {
+		t.Errorf("withYear should fail below timeconst.MIN_YEAR with the range of chronofield.YEAR, got %v", err)
 	}
 }
 
@@ -512,6 +521,27 @@ func TestPlus(t *testing.T) {
 	if got := gdt.Plus(halfDaysToAdd, timeunit.HALF_DAYS); got.t != expectedTime {
 		t.Errorf("Plus(HALF_DAYS) failed, expected %v, got %v", expectedTime, got.t)
 	}
+
+	// Test addition of days, weeks, months and years
+	calendarCases := []struct {
+		unit     timeunit.TimeUnit
+		amount   int
+		expected time.Time
+	}{
+		{timeunit.DAYS, 3, initialTime.AddDate(0, 0, 3)},
+		{timeunit.WEEKS, 2, initialTime.AddDate(0, 0, 14)},
+		{timeunit.MONTHS, 5, initialTime.AddDate(0, 5, 0)},
+		{timeunit.YEARS, -1, initialTime.AddDate(-1, 0, 0)},
+		{timeunit.QUARTERS, 2, initialTime.AddDate(0, 6, 0)},
+		{timeunit.HALF_YEARS, 1, initialTime.AddDate(0, 6, 0)},
+		{timeunit.DECADES, 1, initialTime.AddDate(10, 0, 0)},
+		{timeunit.CENTURIES, -1, initialTime.AddDate(-100, 0, 0)},
+	}
+	for _, c := range calendarCases {
+		if got := gdt.Plus(c.amount, c.unit); got.t != c.expected {
+			t.Errorf("Plus(%d, %v) failed, expected %v, got %v", c.amount, c.unit, c.expected, got.t)
+		}
+	}
 }
 
 func TestMinusYears(t *testing.T) {
@@ -0,0 +1,9 @@
+package gdatetime
+
+// must returns v and panics if err is not nil, for building test fixtures such as must(YearMonthOf(2024, 2)).
+func must[T any](v T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,238 @@
+package gdatetime
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// intervalLayout describes the accepted interval notation in a ParseError.
+const intervalLayout = "start/end"
+
+// Interval is the half-open range of instants from Start, inclusive, to End, exclusive,
+// so the interval of a day ends at the start of the next day and adjacent intervals do not overlap.
+// An Interval with Start equal to End is empty. Use IntervalOf to get a checked Interval.
+type Interval struct {
+	Start *GDateTime
+	End   *GDateTime
+}
+
+// IntervalOf Obtains an Interval from start, inclusive, to end, exclusive. An end before the start returns ErrInvalidInterval.
+func IntervalOf(start, end *GDateTime) (Interval, error) {
+	if start == nil || end == nil || end.t.Before(start.t) {
+		return Interval{}, ErrInvalidInterval
+	}
+	return Interval{Start: start, End: end}, nil
+}
+
+// DayOf returns the interval of the day of the GDateTime, from its start to the start of the next day, in its location.
+func DayOf(gdt *GDateTime) Interval {
+	year, month, day := gdt.t.Date()
+	return localDaysInterval(year, month, day, 1, gdt.t.Location())
+}
+
+// WeekOf returns the interval of the week of the GDateTime, starting on weekStart, such as time.Monday.
+func WeekOf(gdt *GDateTime, weekStart time.Weekday) Interval {
+	year, month, day := gdt.t.Date()
+	back := (int(gdt.t.Weekday()) - int(weekStart) + 7) % 7
+	return localDaysInterval(year, month, day-back, 7, gdt.t.Location())
+}
+
+// MonthOf returns the interval of the month of the GDateTime, from its first day to the first day of the next month.
+func MonthOf(gdt *GDateTime) Interval {
+	year, month, _ := gdt.t.Date()
+	loc := gdt.t.Location()
+	return Interval{
+		Start: Create(time.Date(year, month, 1, 0, 0, 0, 0, loc)),
+		End:   Create(time.Date(year, month+1, 1, 0, 0, 0, 0, loc)),
+	}
+}
+
+// localDaysInterval returns the interval of the days from the date, midnight to midnight in the location.
+func localDaysInterval(year int, month time.Month, day, days int, loc *time.Location) Interval {
+	return Interval{
+		Start: Create(time.Date(year, month, day, 0, 0, 0, 0, loc)),
+		End:   Create(time.Date(year, month, day+days, 0, 0, 0, 0, loc)),
+	}
+}
+
+// ParseInterval parses an ISO 8601 interval made of two RFC 3339 date-times or a date-time and a duration:
+// "2024-06-01T00:00:00Z/2024-07-01T00:00:00Z", "2024-06-01T00:00:00Z/P1M" or "P1D/2024-06-02T00:00:00Z".
+// A failure is returned as a *ParseError.
+func ParseInterval(s string) (Interval, error) {
+	left, right, found := strings.Cut(s, "/")
+	if !found || strings.Contains(right, "/") {
+		return Interval{}, &ParseError{Input: s, Layout: intervalLayout, Offset: -1, Reason: `expected one "/"`}
+	}
+	isDuration := func(part string) bool {
+		return strings.HasPrefix(strings.TrimLeft(strings.ToUpper(part), "+-"), "P")
+	}
+
+	var start, end *GDateTime
+	switch {
+	case isDuration(left) && isDuration(right):
+		return Interval{}, &ParseError{Input: s, Layout: intervalLayout, Offset: -1, Reason: "an interval needs a start or an end"}
+	case isDuration(left):
+		d, err := ParseDuration(left)
+		if err != nil {
+			return Interval{}, &ParseError{Input: s, Layout: intervalLayout, Offset: 0, Reason: "invalid duration", Err: err}
+		}
+		t, err := time.Parse(time.RFC3339Nano, right)
+		if err != nil {
+			return Interval{}, &ParseError{Input: s, Layout: intervalLayout, Offset: len(left) + 1, Reason: "invalid end", Err: err}
+		}
+		end = Create(t)
+		start = end.MinusDuration(d)
+	default:
+		t, err := time.Parse(time.RFC3339Nano, left)
+		if err != nil {
+			return Interval{}, &ParseError{Input: s, Layout: intervalLayout, Offset: 0, Reason: "invalid start", Err: err}
+		}
+		start = Create(t)
+		if isDuration(right) {
+			d, err := ParseDuration(right)
+			if err != nil {
+				return Interval{}, &ParseError{Input: s, Layout: intervalLayout, Offset: len(left) + 1, Reason: "invalid duration", Err: err}
+			}
+			end = start.PlusDuration(d)
+		} else {
+			t, err := time.Parse(time.RFC3339Nano, right)
+			if err != nil {
+				return Interval{}, &ParseError{Input: s, Layout: intervalLayout, Offset: len(left) + 1, Reason: "invalid end", Err: err}
+			}
+			end = Create(t)
+		}
+	}
+	interval, err := IntervalOf(start, end)
+	if err != nil {
+		return Interval{}, &ParseError{Input: s, Layout: intervalLayout, Offset: -1, Reason: "end is before start", Err: err}
+	}
+	return interval, nil
+}
+
+// IsEmpty checks if the interval contains no instant, that is Start equals End.
+func (i Interval) IsEmpty() bool {
+	return i.Start.t.Equal(i.End.t)
+}
+
+// Duration returns the exact elapsed time from Start to End.
+func (i Interval) Duration() time.Duration {
+	return i.End.t.Sub(i.Start.t)
+}
+
+// Contains checks if the instant is in the interval, at or after Start and before End.
+func (i Interval) Contains(gdt *GDateTime) bool {
+	return !gdt.t.Before(i.Start.t) && gdt.t.Before(i.End.t)
+}
+
+// Encloses checks if every instant of the other interval is in this interval.
+func (i Interval) Encloses(other Interval) bool {
+	return !other.Start.t.Before(i.Start.t) && !other.End.t.After(i.End.t)
+}
+
+// Overlaps checks if the intervals share at least one instant. Intervals that only abut do not overlap.
+func (i Interval) Overlaps(other Interval) bool {
+	return i.Start.t.Before(other.End.t) && other.Start.t.Before(i.End.t)
+}
+
+// Abuts checks if one interval ends exactly where the other starts.
+func (i Interval) Abuts(other Interval) bool {
+	return i.End.t.Equal(other.Start.t) || other.End.t.Equal(i.Start.t)
+}
+
+// Intersection returns the instants shared by the intervals, false if they do not overlap.
+func (i Interval) Intersection(other Interval) (Interval, bool) {
+	if !i.Overlaps(other) {
+		return Interval{}, false
+	}
+	return Interval{Start: laterOf(i.Start, other.Start), End: earlierOf(i.End, other.End)}, true
+}
+
+// Union returns the interval covering both intervals, false if they neither overlap nor abut,
+// as the union would then have a hole.
+func (i Interval) Union(other Interval) (Interval, bool) {
+	if !i.Overlaps(other) && !i.Abuts(other) {
+		return Interval{}, false
+	}
+	return i.Span(other), true
+}
+
+// Gap returns the interval between the intervals, false if they overlap or abut.
+func (i Interval) Gap(other Interval) (Interval, bool) {
+	switch {
+	case i.End.t.Before(other.Start.t):
+		return Interval{Start: i.End, End: other.Start}, true
+	case other.End.t.Before(i.Start.t):
+		return Interval{Start: other.End, End: i.Start}, true
+	default:
+		return Interval{}, false
+	}
+}
+
+// Span returns the smallest interval covering both intervals, including the gap between them.
+func (i Interval) Span(other Interval) Interval {
+	return Interval{Start: earlierOf(i.Start, other.Start), End: laterOf(i.End, other.End)}
+}
+
+// IsEqual checks if the intervals have the same start and end instants, whatever their locations.
+func (i Interval) IsEqual(other Interval) bool {
+	return i.Start.t.Equal(other.Start.t) && i.End.t.Equal(other.End.t)
+}
+
+// ISODuration returns the length of the interval as an ISO 8601 Duration: the largest Period of whole years,
+// months and days that fits from Start on the wall clock, then the remaining exact time, such as "P1M" or "P29DT23H".
+func (i Interval) ISODuration() Duration {
+	p := PeriodBetween(i.Start, i.End)
+	if i.Start.PlusPeriod(p).t.After(i.End.t) {
+		// the time of day of End is before the time of day of Start, the last day is not whole
+		p = PeriodBetween(i.Start, i.End.MinusDays(1))
+	}
+	rest := i.End.t.Sub(i.Start.PlusPeriod(p).t)
+	return DurationOf(p, rest)
+}
+
+// String formats the interval in ISO 8601 "start/end" notation with RFC 3339 date-times.
+func (i Interval) String() string {
+	return i.Start.t.Format(time.RFC3339Nano) + "/" + i.End.t.Format(time.RFC3339Nano)
+}
+
+// StringWithDuration formats the interval in ISO 8601 "start/duration" notation, such as "2024-06-01T00:00:00Z/P1M".
+func (i Interval) StringWithDuration() string {
+	return i.Start.t.Format(time.RFC3339Nano) + "/" + i.ISODuration().String()
+}
+
+// MarshalText implements encoding.TextMarshaler using the "start/end" notation.
+func (i Interval) MarshalText() ([]byte, error) {
+	if i.Start == nil || i.End == nil {
+		return nil, fmt.Errorf("MarshalText: %w", ErrInvalidInterval)
+	}
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting every notation of ParseInterval.
+func (i *Interval) UnmarshalText(text []byte) error {
+	if i == nil {
+		return errors.New("UnmarshalText on nil pointer")
+	}
+	parsed, err := ParseInterval(string(text))
+	if err != nil {
+		return err
+	}
+	*i = parsed
+	return nil
+}
+
+func earlierOf(a, b *GDateTime) *GDateTime {
+	if b.t.Before(a.t) {
+		return b
+	}
+	return a
+}
+
+func laterOf(a, b *GDateTime) *GDateTime {
+	if b.t.After(a.t) {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,257 @@
+package gdatetime
+
+import (
+	"math/rand/v2"
+	"sync"
+	"time"
+)
+
+// IntervalID identifies an entry of an IntervalIndex, returned by Insert.
+type IntervalID uint64
+
+// IndexEntry is an interval of an IntervalIndex with its value.
+type IndexEntry[T any] struct {
+	ID       IntervalID
+	Interval Interval
+	Value    T
+}
+
+// IntervalIndex is an in-memory index of values by half-open interval, such as bookings by their time slot,
+// answering which intervals overlap a window or contain an instant.
+// The entries are kept in a treap ordered by start where every node also holds the latest end of its subtree,
+// so queries skip the subtrees that end too early: inserting and deleting cost O(log n),
+// and a query returning k entries costs O(log n + k) for intervals of similar lengths.
+// A second treap ordered by end answers NearestBefore.
+// An IntervalIndex is safe for concurrent use, queries run in parallel and return copies of the entries.
+type IntervalIndex[T any] struct {
+	mu      sync.RWMutex
+	byStart *indexNode[T]
+	byEnd   *indexNode[T]
+	entries map[IntervalID]IndexEntry[T]
+	nextID  IntervalID
+}
+
+// indexNode is a node of a treap of an IntervalIndex, ordered by key then ID.
+type indexNode[T any] struct {
+	entry       IndexEntry[T]
+	key         time.Time // the start in the byStart treap, the end in the byEnd treap
+	maxEnd      time.Time // the latest end of the subtree
+	priority    uint64
+	left, right *indexNode[T]
+}
+
+// NewIntervalIndex returns an empty IntervalIndex.
+func NewIntervalIndex[T any]() *IntervalIndex[T] {
+	return &IntervalIndex[T]{entries: make(map[IntervalID]IndexEntry[T])}
+}
+
+// Len returns the number of entries in the index.
+func (x *IntervalIndex[T]) Len() int {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	return len(x.entries)
+}
+
+// Insert adds the value with its interval and returns the ID to delete it, in O(log n).
+func (x *IntervalIndex[T]) Insert(interval Interval, value T) IntervalID {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.nextID++
+	entry := IndexEntry[T]{ID: x.nextID, Interval: interval, Value: value}
+	x.entries[entry.ID] = entry
+	priority := rand.Uint64()
+	x.byStart = insertIndexNode(x.byStart, &indexNode[T]{entry: entry, key: interval.Start.t, priority: priority})
+	x.byEnd = insertIndexNode(x.byEnd, &indexNode[T]{entry: entry, key: interval.End.t, priority: priority})
+	return entry.ID
+}
+
+// Delete removes the entry with the ID in O(log n), false if there is none.
+func (x *IntervalIndex[T]) Delete(id IntervalID) bool {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	entry, ok := x.entries[id]
+	if !ok {
+		return false
+	}
+	delete(x.entries, id)
+	x.byStart = deleteIndexNode(x.byStart, entry.Interval.Start.t, id)
+	x.byEnd = deleteIndexNode(x.byEnd, entry.Interval.End.t, id)
+	return true
+}
+
+// Get returns the entry with the ID, false if there is none.
+func (x *IntervalIndex[T]) Get(id IntervalID) (IndexEntry[T], bool) {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	entry, ok := x.entries[id]
+	return entry, ok
+}
+
+// Overlapping returns the entries sharing at least one instant with the window, as Interval.Overlaps, ordered by start.
+func (x *IntervalIndex[T]) Overlapping(window Interval) []IndexEntry[T] {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	var result []IndexEntry[T]
+	collectOverlapping(x.byStart, window.Start.t, window.End.t, func(n *indexNode[T]) {
+		result = append(result, n.entry)
+	})
+	return result
+}
+
+// At returns the entries whose interval contains the instant, as Interval.Contains, ordered by start.
+func (x *IntervalIndex[T]) At(instant *GDateTime) []IndexEntry[T] {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	var result []IndexEntry[T]
+	x.stab(instant.t, func(n *indexNode[T]) {
+		result = append(result, n.entry)
+	})
+	return result
+}
+
+// Stab returns how many entries contain the instant, such as the number of bookings running at 10:00, without copying them.
+func (x *IntervalIndex[T]) Stab(instant *GDateTime) int {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	count := 0
+	x.stab(instant.t, func(*indexNode[T]) {
+		count++
+	})
+	return count
+}
+
+// stab calls report for the nodes containing the instant t, the caller holds the lock.
+func (x *IntervalIndex[T]) stab(t time.Time, report func(*indexNode[T])) {
+	// the nodes starting at or before t, which is before the first nanosecond after t, and ending after t
+	collectOverlapping(x.byStart, t, t.Add(time.Nanosecond), report)
+}
+
+// NearestBefore returns the entry ending last at or before the instant, false if there is none.
+// Entries with the same end are ordered by ID, the last inserted is returned.
+func (x *IntervalIndex[T]) NearestBefore(instant *GDateTime) (IndexEntry[T], bool) {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	var found *indexNode[T]
+	for n := x.byEnd; n != nil; {
+		if n.key.After(instant.t) {
+			n = n.left
+		} else {
+			found, n = n, n.right
+		}
+	}
+	if found == nil {
+		return IndexEntry[T]{}, false
+	}
+	return found.entry, true
+}
+
+// NearestAfter returns the entry starting first at or after the instant, false if there is none.
+// Entries with the same start are ordered by ID, the first inserted is returned.
+func (x *IntervalIndex[T]) NearestAfter(instant *GDateTime) (IndexEntry[T], bool) {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	var found *indexNode[T]
+	for n := x.byStart; n != nil; {
+		if n.key.Before(instant.t) {
+			n = n.right
+		} else {
+			found, n = n, n.left
+		}
+	}
+	if found == nil {
+		return IndexEntry[T]{}, false
+	}
+	return found.entry, true
+}
+
+// collectOverlapping calls visit in order for the nodes starting before end and ending after start.
+// A subtree whose latest end is not after start is skipped, as is the right subtree of a node starting at or after end.
+func collectOverlapping[T any](n *indexNode[T], start, end time.Time, visit func(*indexNode[T])) {
+	if n == nil || !n.maxEnd.After(start) {
+		return
+	}
+	collectOverlapping(n.left, start, end, visit)
+	if !n.key.Before(end) {
+		return
+	}
+	if n.entry.Interval.End.t.After(start) {
+		visit(n)
+	}
+	collectOverlapping(n.right, start, end, visit)
+}
+
+// lessIndexNode orders the nodes by key then ID.
+func lessIndexNode[T any](n *indexNode[T], key time.Time, id IntervalID) bool {
+	if c := n.key.Compare(key); c != 0 {
+		return c < 0
+	}
+	return n.entry.ID < id
+}
+
+func insertIndexNode[T any](root, node *indexNode[T]) *indexNode[T] {
+	node.maxEnd = node.entry.Interval.End.t
+	left, right := splitIndexNodes(root, node.key, node.entry.ID)
+	return mergeIndexNodes(mergeIndexNodes(left, node), right)
+}
+
+func deleteIndexNode[T any](root *indexNode[T], key time.Time, id IntervalID) *indexNode[T] {
+	if root == nil {
+		return nil
+	}
+	if root.key.Equal(key) && root.entry.ID == id {
+		return mergeIndexNodes(root.left, root.right)
+	}
+	if lessIndexNode(root, key, id) {
+		root.right = deleteIndexNode(root.right, key, id)
+	} else {
+		root.left = deleteIndexNode(root.left, key, id)
+	}
+	root.update()
+	return root
+}
+
+// splitIndexNodes splits the subtree into the nodes before (key, id) and the others.
+func splitIndexNodes[T any](n *indexNode[T], key time.Time, id IntervalID) (*indexNode[T], *indexNode[T]) {
+	if n == nil {
+		return nil, nil
+	}
+	if lessIndexNode(n, key, id) {
+		left, right := splitIndexNodes(n.right, key, id)
+		n.right = left
+		n.update()
+		return n, right
+	}
+	left, right := splitIndexNodes(n.left, key, id)
+	n.left = right
+	n.update()
+	return left, n
+}
+
+// mergeIndexNodes joins two subtrees where every node of a is before every node of b.
+func mergeIndexNodes[T any](a, b *indexNode[T]) *indexNode[T] {
+	switch {
+	case a == nil:
+		return b
+	case b == nil:
+		return a
+	case a.priority > b.priority:
+		a.right = mergeIndexNodes(a.right, b)
+		a.update()
+		return a
+	default:
+		b.left = mergeIndexNodes(a, b.left)
+		b.update()
+		return b
+	}
+}
+
+// update recomputes the latest end of the subtree from the children.
+func (n *indexNode[T]) update() {
+	n.maxEnd = n.entry.Interval.End.t
+	if n.left != nil && n.left.maxEnd.After(n.maxEnd) {
+		n.maxEnd = n.left.maxEnd
+	}
+	if n.right != nil && n.right.maxEnd.After(n.maxEnd) {
+		n.maxEnd = n.right.maxEnd
+	}
+}
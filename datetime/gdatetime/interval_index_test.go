@@ -0,0 +1,239 @@
+package gdatetime
+
+import (
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+// randomSpec returns a random interval of up to 31 minutes in the first 256 minutes after setModelBase.
+func randomSpec(r *rand.Rand) spec {
+	return spec{Start: uint8(r.IntN(256)), Length: uint8(r.IntN(32))}
+}
+
+func entryIDs[T any](entries []IndexEntry[T]) []IntervalID {
+	ids := make([]IntervalID, 0, len(entries))
+	for _, entry := range entries {
+		ids = append(ids, entry.ID)
+	}
+	return ids
+}
+
+// TestIntervalIndexMatchesScan checks every query against a linear scan of the live entries.
+func TestIntervalIndexMatchesScan(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	index := NewIntervalIndex[int]()
+	live := map[IntervalID]Interval{}
+	var ids []IntervalID
+
+	for step := 0; step < 3000; step++ {
+		if len(ids) > 0 && r.IntN(3) == 0 {
+			i := r.IntN(len(ids))
+			id := ids[i]
+			ids = slices.Delete(ids, i, i+1)
+			delete(live, id)
+			if !index.Delete(id) || index.Delete(id) {
+				t.Fatalf("Delete(%d) should succeed once", id)
+			}
+		} else {
+			interval := randomSpec(r).interval()
+			id := index.Insert(interval, step)
+			live[id] = interval
+			ids = append(ids, id)
+		}
+		if index.Len() != len(live) {
+			t.Fatalf("Len = %d, want %d", index.Len(), len(live))
+		}
+
+		window := randomSpec(r).interval()
+		instant := minuteAt(r.IntN(300))
+		var overlapping, containing []IntervalID
+		var before, after IntervalID
+		var beforeEnd, afterStart time.Time
+		for id, interval := range live {
+			if interval.Overlaps(window) {
+				overlapping = append(overlapping, id)
+			}
+			if interval.Contains(instant) {
+				containing = append(containing, id)
+			}
+			end := interval.End.t
+			if !end.After(instant.t) && (before == 0 || end.After(beforeEnd) || (end.Equal(beforeEnd) && id > before)) {
+				before, beforeEnd = id, end
+			}
+			start := interval.Start.t
+			if !start.Before(instant.t) && (after == 0 || start.Before(afterStart) || (start.Equal(afterStart) && id < after)) {
+				after, afterStart = id, start
+			}
+		}
+
+		got := index.Overlapping(window)
+		for i := 1; i < len(got); i++ {
+			if got[i].Interval.Start.t.Before(got[i-1].Interval.Start.t) {
+				t.Fatal("Overlapping should order the entries by start")
+			}
+		}
+		gotIDs := entryIDs(got)
+		slices.Sort(gotIDs)
+		slices.Sort(overlapping)
+		if !slices.Equal(gotIDs, overlapping) {
+			t.Fatalf("Overlapping(%v) = %v, want %v", window, gotIDs, overlapping)
+		}
+		gotIDs = entryIDs(index.At(instant))
+		slices.Sort(gotIDs)
+		slices.Sort(containing)
+		if !slices.Equal(gotIDs, containing) || index.Stab(instant) != len(containing) {
+			t.Fatalf("At(%v) = %v, Stab = %d, want %v", instant.t, gotIDs, index.Stab(instant), containing)
+		}
+		if entry, ok := index.NearestBefore(instant); ok != (before != 0) || entry.ID != before {
+			t.Fatalf("NearestBefore(%v) = %d, %v, want %d", instant.t, entry.ID, ok, before)
+		}
+		if entry, ok := index.NearestAfter(instant); ok != (after != 0) || entry.ID != after {
+			t.Fatalf("NearestAfter(%v) = %d, %v, want %d", instant.t, entry.ID, ok, after)
+		}
+	}
+}
+
+func TestIntervalIndexEntries(t *testing.T) {
+	index := NewIntervalIndex[string]()
+	meeting := index.Insert(Interval{Start: utcAt(1, 9), End: utcAt(1, 10)}, "standup")
+	index.Insert(Interval{Start: utcAt(1, 10), End: utcAt(1, 12)}, "review")
+
+	if entry, ok := index.Get(meeting); !ok || entry.Value != "standup" {
+		t.Errorf("Get = %v, %v", entry, ok)
+	}
+	at := index.At(utcAt(1, 10))
+	if len(at) != 1 || at[0].Value != "review" {
+		t.Errorf("At(10:00) = %v, want only review as intervals are half-open", at)
+	}
+	if got := index.Overlapping(Interval{Start: utcAt(1, 8), End: utcAt(1, 9)}); len(got) != 0 {
+		t.Errorf("a window ending at the start of an entry should not overlap it, got %v", got)
+	}
+	if entry, ok := index.NearestBefore(utcAt(1, 11)); !ok || entry.Value != "standup" {
+		t.Errorf("NearestBefore(11:00) = %v, %v", entry, ok)
+	}
+	if _, ok := index.NearestAfter(utcAt(1, 11)); ok {
+		t.Error("NearestAfter(11:00) should find nothing")
+	}
+	index.Delete(meeting)
+	if _, ok := index.Get(meeting); ok || index.Len() != 1 {
+		t.Error("Delete should remove the entry")
+	}
+}
+
+func TestIntervalIndexConcurrent(t *testing.T) {
+	index := NewIntervalIndex[int]()
+	const writers, perWriter = 8, 500
+	var wg sync.WaitGroup
+	kept := make([][]IntervalID, writers)
+	for w := 0; w < writers; w++ {
+		wg.Add(2)
+		go func(w int) {
+			defer wg.Done()
+			r := rand.New(rand.NewPCG(uint64(w), 0))
+			for i := 0; i < perWriter; i++ {
+				id := index.Insert(randomSpec(r).interval(), i)
+				if i%2 == 0 {
+					index.Delete(id)
+				} else {
+					kept[w] = append(kept[w], id)
+				}
+			}
+		}(w)
+		go func(w int) {
+			defer wg.Done()
+			r := rand.New(rand.NewPCG(uint64(w), 1))
+			for i := 0; i < perWriter; i++ {
+				instant := minuteAt(r.IntN(300))
+				for _, entry := range index.At(instant) {
+					if !entry.Interval.Contains(instant) {
+						t.Errorf("At returned %v, which does not contain %v", entry.Interval, instant.t)
+						return
+					}
+				}
+				index.Overlapping(randomSpec(r).interval())
+				index.NearestBefore(instant)
+				index.NearestAfter(instant)
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	if index.Len() != writers*perWriter/2 {
+		t.Fatalf("Len = %d, want %d", index.Len(), writers*perWriter/2)
+	}
+	for _, ids := range kept {
+		for _, id := range ids {
+			if _, ok := index.Get(id); !ok {
+				t.Fatalf("entry %d is missing", id)
+			}
+		}
+	}
+	all := index.Overlapping(Interval{Start: minuteAt(-1), End: minuteAt(400)})
+	if len(all) != index.Len() {
+		t.Errorf("Overlapping everything = %d entries, want %d", len(all), index.Len())
+	}
+}
+
+// bookingIndex returns an index of n one-hour bookings spread over a year.
+func bookingIndex(n int) *IntervalIndex[int] {
+	r := rand.New(rand.NewPCG(42, 0))
+	index := NewIntervalIndex[int]()
+	for i := 0; i < n; i++ {
+		start := setModelBase.Add(time.Duration(r.IntN(365*24*60)) * time.Minute)
+		index.Insert(Interval{Start: Create(start), End: Create(start.Add(time.Hour))}, i)
+	}
+	return index
+}
+
+func BenchmarkIntervalIndexInsert(b *testing.B) {
+	index := bookingIndex(50000)
+	start := Create(setModelBase)
+	end := Create(setModelBase.Add(time.Hour))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		id := index.Insert(Interval{Start: start, End: end}, i)
+		index.Delete(id)
+	}
+}
+
+func BenchmarkIntervalIndexOverlapping(b *testing.B) {
+	index := bookingIndex(50000)
+	r := rand.New(rand.NewPCG(7, 0))
+	windows := make([]Interval, 1024)
+	for i := range windows {
+		start := setModelBase.Add(time.Duration(r.IntN(365*24*60)) * time.Minute)
+		windows[i] = Interval{Start: Create(start), End: Create(start.Add(2 * time.Hour))}
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		index.Overlapping(windows[i%len(windows)])
+	}
+}
+
+func BenchmarkIntervalIndexOverlappingScan(b *testing.B) {
+	index := bookingIndex(50000)
+	entries := index.Overlapping(Interval{Start: minuteAt(-1), End: Create(setModelBase.AddDate(2, 0, 0))})
+	window := Interval{Start: Create(setModelBase.AddDate(0, 6, 0)), End: Create(setModelBase.AddDate(0, 6, 0).Add(2 * time.Hour))}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var found []IndexEntry[int]
+		for _, entry := range entries {
+			if entry.Interval.Overlaps(window) {
+				found = append(found, entry)
+			}
+		}
+	}
+}
+
+func BenchmarkIntervalIndexAtParallel(b *testing.B) {
+	index := bookingIndex(50000)
+	b.RunParallel(func(pb *testing.PB) {
+		r := rand.New(rand.NewPCG(rand.Uint64(), 0))
+		for pb.Next() {
+			index.At(Create(setModelBase.Add(time.Duration(r.IntN(365*24*60)) * time.Minute)))
+		}
+	})
+}
@@ -0,0 +1,260 @@
+package gdatetime
+
+import (
+	"iter"
+	"math/rand/v2"
+	"strings"
+	"time"
+)
+
+// IntervalSet is a set of instants kept as normalized half-open intervals: sorted, non-empty,
+// and with overlapping or abutting intervals merged, so [09:00, 12:00) and [12:00, 17:00) are stored as [09:00, 17:00).
+// The intervals are kept in a treap ordered by start, so adding or subtracting an interval costs O(log n)
+// plus the intervals it merges or removes. The zero value is an empty set ready to use.
+// An IntervalSet is not safe for concurrent modification.
+type IntervalSet struct {
+	root *intervalNode
+	size int
+}
+
+// intervalNode is a node of the treap, a binary search tree on the start instants that is a heap on the random priorities.
+type intervalNode struct {
+	interval    Interval
+	priority    uint64
+	left, right *intervalNode
+}
+
+// NewIntervalSet returns a set of the instants of the intervals.
+func NewIntervalSet(intervals ...Interval) *IntervalSet {
+	s := &IntervalSet{}
+	s.Add(intervals...)
+	return s
+}
+
+// Len returns the number of normalized intervals in the set.
+func (s *IntervalSet) Len() int {
+	return s.size
+}
+
+// IsEmpty checks if the set contains no instant.
+func (s *IntervalSet) IsEmpty() bool {
+	return s.size == 0
+}
+
+// Add adds the instants of the intervals to the set, merging the intervals they overlap or abut.
+func (s *IntervalSet) Add(intervals ...Interval) {
+	for _, interval := range intervals {
+		s.add(interval)
+	}
+}
+
+func (s *IntervalSet) add(interval Interval) {
+	if interval.IsEmpty() {
+		return
+	}
+	start, end := interval.Start, interval.End
+	// before holds the intervals starting before the new one, the last of them may reach it
+	before, rest := splitIntervals(s.root, start.t, false)
+	if last := lastInterval(before); last != nil && !last.interval.End.t.Before(start.t) {
+		before = s.removeLast(before)
+		start, end = last.interval.Start, laterOf(end, last.interval.End)
+	}
+	// merged holds the intervals starting inside the new one or where it ends, they are absorbed
+	merged, after := splitIntervals(rest, end.t, true)
+	if last := lastInterval(merged); last != nil {
+		end = laterOf(end, last.interval.End)
+	}
+	s.size -= countIntervals(merged)
+	s.size++
+	node := &intervalNode{interval: Interval{Start: start, End: end}, priority: rand.Uint64()}
+	s.root = mergeIntervals(mergeIntervals(before, node), after)
+}
+
+// Subtract removes the instants of the intervals from the set, trimming or splitting the intervals they overlap.
+func (s *IntervalSet) Subtract(intervals ...Interval) {
+	for _, interval := range intervals {
+		s.subtract(interval)
+	}
+}
+
+func (s *IntervalSet) subtract(interval Interval) {
+	if interval.IsEmpty() {
+		return
+	}
+	start, end := interval.Start, interval.End
+	var kept []Interval
+	before, rest := splitIntervals(s.root, start.t, false)
+	if last := lastInterval(before); last != nil && last.interval.End.t.After(start.t) {
+		before = s.removeLast(before)
+		kept = append(kept, Interval{Start: last.interval.Start, End: start})
+		if last.interval.End.t.After(end.t) {
+			kept = append(kept, Interval{Start: end, End: last.interval.End})
+		}
+	}
+	removed, after := splitIntervals(rest, end.t, false)
+	if last := lastInterval(removed); last != nil && last.interval.End.t.After(end.t) {
+		kept = append(kept, Interval{Start: end, End: last.interval.End})
+	}
+	s.size -= countIntervals(removed)
+	s.root = mergeIntervals(before, after)
+	// the kept pieces neither overlap nor abut what is left, adding them only inserts them
+	s.Add(kept...)
+}
+
+// removeLast removes the last interval of the subtree, counting it out of the set.
+func (s *IntervalSet) removeLast(n *intervalNode) *intervalNode {
+	s.size--
+	return removeLastInterval(n)
+}
+
+// Intersect returns a new set of the instants in both sets.
+func (s *IntervalSet) Intersect(other *IntervalSet) *IntervalSet {
+	result := &IntervalSet{}
+	next, stop := iter.Pull(other.All())
+	defer stop()
+	b, ok := next()
+	for a := range s.All() {
+		for ok && !b.Start.t.After(a.End.t) {
+			if overlap, found := a.Intersection(b); found {
+				result.Add(overlap)
+			}
+			if b.End.t.After(a.End.t) {
+				break
+			}
+			b, ok = next()
+		}
+	}
+	return result
+}
+
+// Complement returns a new set of the instants of within that are not in the set, such as the free time of a working day.
+func (s *IntervalSet) Complement(within Interval) *IntervalSet {
+	result := NewIntervalSet(within)
+	for interval := range s.All() {
+		if !interval.Start.t.Before(within.End.t) {
+			break
+		}
+		result.Subtract(interval)
+	}
+	return result
+}
+
+// Clone returns a copy of the set.
+func (s *IntervalSet) Clone() *IntervalSet {
+	return &IntervalSet{root: cloneIntervals(s.root), size: s.size}
+}
+
+// TotalDuration returns the sum of the durations of the intervals of the set.
+func (s *IntervalSet) TotalDuration() time.Duration {
+	var total time.Duration
+	for interval := range s.All() {
+		total += interval.Duration()
+	}
+	return total
+}
+
+// Contains checks if the instant is in one of the intervals of the set, in O(log n).
+func (s *IntervalSet) Contains(gdt *GDateTime) bool {
+	var candidate *intervalNode
+	for n := s.root; n != nil; {
+		if n.interval.Start.t.After(gdt.t) {
+			n = n.left
+		} else {
+			candidate, n = n, n.right
+		}
+	}
+	return candidate != nil && candidate.interval.Contains(gdt)
+}
+
+// All returns an iterator over the intervals of the set in order.
+func (s *IntervalSet) All() iter.Seq[Interval] {
+	return func(yield func(Interval) bool) {
+		walkIntervals(s.root, yield)
+	}
+}
+
+// Intervals returns the intervals of the set in order.
+func (s *IntervalSet) Intervals() []Interval {
+	intervals := make([]Interval, 0, s.size)
+	for interval := range s.All() {
+		intervals = append(intervals, interval)
+	}
+	return intervals
+}
+
+// String returns the intervals of the set in ISO 8601 notation, such as "[2024-06-01T09:00:00Z/2024-06-01T12:00:00Z]".
+func (s *IntervalSet) String() string {
+	parts := make([]string, 0, s.size)
+	for interval := range s.All() {
+		parts = append(parts, interval.String())
+	}
+	return "[" + strings.Join(parts, ", ") + "]"
+}
+
+// splitIntervals splits the subtree into the nodes starting before t, or at t when inclusive, and the others.
+func splitIntervals(n *intervalNode, t time.Time, inclusive bool) (*intervalNode, *intervalNode) {
+	if n == nil {
+		return nil, nil
+	}
+	start := n.interval.Start.t
+	if start.Before(t) || (inclusive && start.Equal(t)) {
+		left, right := splitIntervals(n.right, t, inclusive)
+		n.right = left
+		return n, right
+	}
+	left, right := splitIntervals(n.left, t, inclusive)
+	n.left = right
+	return left, n
+}
+
+// mergeIntervals joins two subtrees where every start of a is before every start of b.
+func mergeIntervals(a, b *intervalNode) *intervalNode {
+	switch {
+	case a == nil:
+		return b
+	case b == nil:
+		return a
+	case a.priority > b.priority:
+		a.right = mergeIntervals(a.right, b)
+		return a
+	default:
+		b.left = mergeIntervals(a, b.left)
+		return b
+	}
+}
+
+func lastInterval(n *intervalNode) *intervalNode {
+	for n != nil && n.right != nil {
+		n = n.right
+	}
+	return n
+}
+
+func removeLastInterval(n *intervalNode) *intervalNode {
+	if n.right == nil {
+		return n.left
+	}
+	n.right = removeLastInterval(n.right)
+	return n
+}
+
+func countIntervals(n *intervalNode) int {
+	if n == nil {
+		return 0
+	}
+	return 1 + countIntervals(n.left) + countIntervals(n.right)
+}
+
+func cloneIntervals(n *intervalNode) *intervalNode {
+	if n == nil {
+		return nil
+	}
+	return &intervalNode{interval: n.interval, priority: n.priority, left: cloneIntervals(n.left), right: cloneIntervals(n.right)}
+}
+
+func walkIntervals(n *intervalNode, yield func(Interval) bool) bool {
+	if n == nil {
+		return true
+	}
+	return walkIntervals(n.left, yield) && yield(n.interval) && walkIntervals(n.right, yield)
+}
@@ -0,0 +1,212 @@
+package gdatetime
+
+import (
+	"testing"
+	"testing/quick"
+	"time"
+)
+
+// setModelSize is the number of minutes covered by the model of the property tests.
+const setModelSize = 300
+
+var setModelBase = time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+// spec is a random interval for the property tests, in minutes from setModelBase.
+type spec struct {
+	Start, Length uint8
+}
+
+func (sp spec) interval() Interval {
+	start := int(sp.Start)
+	end := start + int(sp.Length%32)
+	return Interval{Start: minuteAt(start), End: minuteAt(end)}
+}
+
+func minuteAt(minute int) *GDateTime {
+	return Create(setModelBase.Add(time.Duration(minute) * time.Minute))
+}
+
+// model is the set of the minutes covered by intervals, the reference the IntervalSet is checked against.
+type model [setModelSize]bool
+
+func modelOf(specs []spec) model {
+	var m model
+	for _, sp := range specs {
+		m.set(sp, true)
+	}
+	return m
+}
+
+func (m *model) set(sp spec, value bool) {
+	start := int(sp.Start)
+	for minute := start; minute < start+int(sp.Length%32); minute++ {
+		m[minute] = value
+	}
+}
+
+func setOf(specs []spec) *IntervalSet {
+	s := &IntervalSet{}
+	for _, sp := range specs {
+		s.Add(sp.interval())
+	}
+	return s
+}
+
+// checkSet checks that the set is normalized and covers exactly the minutes of the model.
+func checkSet(t *testing.T, s *IntervalSet, m model) bool {
+	t.Helper()
+	var got model
+	intervals := s.Intervals()
+	if len(intervals) != s.Len() {
+		t.Errorf("Len = %d, but %d intervals", s.Len(), len(intervals))
+		return false
+	}
+	for i, interval := range intervals {
+		if interval.IsEmpty() || interval.End.t.Before(interval.Start.t) {
+			t.Errorf("interval %v is empty", interval)
+			return false
+		}
+		if i > 0 && !intervals[i-1].End.t.Before(interval.Start.t) {
+			t.Errorf("intervals %v and %v are not merged", intervals[i-1], interval)
+			return false
+		}
+		from := int(interval.Start.t.Sub(setModelBase) / time.Minute)
+		to := int(interval.End.t.Sub(setModelBase) / time.Minute)
+		for minute := from; minute < to; minute++ {
+			got[minute] = true
+		}
+	}
+	if got != m {
+		t.Errorf("set %v does not match the model", s)
+		return false
+	}
+	var minutes int
+	for minute := range m {
+		if m[minute] {
+			minutes++
+		}
+		if s.Contains(minuteAt(minute)) != m[minute] {
+			t.Errorf("Contains(minute %d) = %v, want %v", minute, !m[minute], m[minute])
+			return false
+		}
+	}
+	if s.TotalDuration() != time.Duration(minutes)*time.Minute {
+		t.Errorf("TotalDuration = %v, want %d minutes", s.TotalDuration(), minutes)
+		return false
+	}
+	return true
+}
+
+func checkProperty(t *testing.T, property any) {
+	t.Helper()
+	if err := quick.Check(property, &quick.Config{MaxCount: 300}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestIntervalSetAdd(t *testing.T) {
+	checkProperty(t, func(specs []spec) bool {
+		return checkSet(t, setOf(specs), modelOf(specs))
+	})
+}
+
+func TestIntervalSetSubtract(t *testing.T) {
+	checkProperty(t, func(added, subtracted []spec) bool {
+		s := setOf(added)
+		m := modelOf(added)
+		for _, sp := range subtracted {
+			s.Subtract(sp.interval())
+			m.set(sp, false)
+		}
+		return checkSet(t, s, m)
+	})
+}
+
+func TestIntervalSetIntersect(t *testing.T) {
+	checkProperty(t, func(a, b []spec) bool {
+		ma, mb := modelOf(a), modelOf(b)
+		var want model
+		for minute := range want {
+			want[minute] = ma[minute] && mb[minute]
+		}
+		sa, sb := setOf(a), setOf(b)
+		// intersection is commutative and leaves its operands unchanged
+		return checkSet(t, sa.Intersect(sb), want) && checkSet(t, sb.Intersect(sa), want) &&
+			checkSet(t, sa, ma) && checkSet(t, sb, mb)
+	})
+}
+
+func TestIntervalSetComplement(t *testing.T) {
+	checkProperty(t, func(specs []spec, within spec) bool {
+		s := setOf(specs)
+		m := modelOf(specs)
+		var want, inside model
+		for minute := range want {
+			inside[minute] = minute >= int(within.Start) && minute < int(within.Start)+int(within.Length%32)
+			want[minute] = inside[minute] && !m[minute]
+		}
+		complement := s.Complement(within.interval())
+		if !checkSet(t, complement, want) {
+			return false
+		}
+		// the complement of the complement is the set restricted to within
+		var restricted model
+		for minute := range restricted {
+			restricted[minute] = inside[minute] && m[minute]
+		}
+		return checkSet(t, complement.Complement(within.interval()), restricted)
+	})
+}
+
+func TestIntervalSetLaws(t *testing.T) {
+	everything := Interval{Start: minuteAt(0), End: minuteAt(setModelSize)}
+	checkProperty(t, func(a, b []spec) bool {
+		sa, sb := setOf(a), setOf(b)
+		union := sa.Clone()
+		for interval := range sb.All() {
+			union.Add(interval)
+		}
+		// De Morgan: the complement of a union is the intersection of the complements
+		left := union.Complement(everything)
+		right := sa.Complement(everything).Intersect(sb.Complement(everything))
+		if left.String() != right.String() {
+			t.Errorf("complement of union %v != intersection of complements %v", left, right)
+			return false
+		}
+		// a set and its complement partition the whole
+		complement := sa.Complement(everything)
+		if !sa.Intersect(complement).IsEmpty() || sa.TotalDuration()+complement.TotalDuration() != everything.Duration() {
+			t.Errorf("%v and its complement %v do not partition the range", sa, complement)
+			return false
+		}
+		// adding is idempotent and subtracting what was added leaves the difference
+		again := sa.Clone()
+		again.Add(sa.Intervals()...)
+		difference := sa.Clone()
+		difference.Subtract(sb.Intervals()...)
+		return again.String() == sa.String() && difference.String() == sa.Intersect(sb.Complement(everything)).String()
+	})
+}
+
+func TestIntervalSetExample(t *testing.T) {
+	// uptime of June 2024 with two maintenance windows
+	month := MonthOf(Create(time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC)))
+	uptime := NewIntervalSet(month)
+	uptime.Subtract(
+		Interval{Start: utcAt(3, 1), End: utcAt(3, 3)},
+		Interval{Start: utcAt(10, 22), End: utcAt(11, 2)},
+		Interval{Start: utcAt(10, 23), End: utcAt(11, 1)},
+	)
+	if uptime.Len() != 3 || uptime.TotalDuration() != 30*24*time.Hour-6*time.Hour {
+		t.Errorf("uptime = %v, total %v", uptime, uptime.TotalDuration())
+	}
+	if uptime.Contains(utcAt(3, 2)) || !uptime.Contains(utcAt(3, 3)) {
+		t.Error("Contains should exclude the maintenance windows, end excluded")
+	}
+
+	var zero IntervalSet
+	zero.Add(Interval{Start: utcAt(1, 9), End: utcAt(1, 12)}, Interval{Start: utcAt(1, 12), End: utcAt(1, 17)})
+	if zero.Len() != 1 || zero.String() != "[2024-06-01T09:00:00Z/2024-06-01T17:00:00Z]" {
+		t.Errorf("abutting intervals should merge, got %v", &zero)
+	}
+}
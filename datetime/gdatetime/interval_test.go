@@ -0,0 +1,183 @@
+package gdatetime
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func utcAt(day, hour int) *GDateTime {
+	return Create(time.Date(2024, 6, day, hour, 0, 0, 0, time.UTC))
+}
+
+func TestIntervalOf(t *testing.T) {
+	if _, err := IntervalOf(utcAt(2, 0), utcAt(1, 0)); !errors.Is(err, ErrInvalidInterval) {
+		t.Errorf("IntervalOf with end before start should fail with ErrInvalidInterval, got %v", err)
+	}
+	if _, err := IntervalOf(nil, utcAt(1, 0)); !errors.Is(err, ErrInvalidInterval) {
+		t.Errorf("IntervalOf with a nil start should fail with ErrInvalidInterval, got %v", err)
+	}
+	empty := must(IntervalOf(utcAt(1, 0), utcAt(1, 0)))
+	if !empty.IsEmpty() || empty.Contains(utcAt(1, 0)) || empty.Duration() != 0 {
+		t.Error("an interval with start equal to end should be empty")
+	}
+}
+
+func TestIntervalContains(t *testing.T) {
+	i := must(IntervalOf(utcAt(1, 9), utcAt(1, 17)))
+	if !i.Contains(utcAt(1, 9)) || !i.Contains(utcAt(1, 16)) || i.Contains(utcAt(1, 17)) || i.Contains(utcAt(1, 8)) {
+		t.Error("Contains should include the start and exclude the end")
+	}
+	if i.Duration() != 8*time.Hour {
+		t.Errorf("Duration = %v, want 8h", i.Duration())
+	}
+	if !i.Encloses(must(IntervalOf(utcAt(1, 10), utcAt(1, 17)))) || i.Encloses(must(IntervalOf(utcAt(1, 10), utcAt(1, 18)))) {
+		t.Error("Encloses failed")
+	}
+}
+
+func TestIntervalRelations(t *testing.T) {
+	morning := must(IntervalOf(utcAt(1, 9), utcAt(1, 12)))
+	afternoon := must(IntervalOf(utcAt(1, 12), utcAt(1, 17)))
+	lunch := must(IntervalOf(utcAt(1, 11), utcAt(1, 14)))
+	evening := must(IntervalOf(utcAt(1, 19), utcAt(1, 22)))
+
+	if morning.Overlaps(afternoon) || !morning.Abuts(afternoon) || !afternoon.Abuts(morning) {
+		t.Error("adjacent intervals should abut without overlapping")
+	}
+	if !morning.Overlaps(lunch) || morning.Abuts(lunch) {
+		t.Error("morning and lunch should overlap")
+	}
+
+	if got, ok := morning.Intersection(lunch); !ok || !got.IsEqual(must(IntervalOf(utcAt(1, 11), utcAt(1, 12)))) {
+		t.Errorf("Intersection = %v, %v", got, ok)
+	}
+	if _, ok := morning.Intersection(afternoon); ok {
+		t.Error("abutting intervals have no intersection")
+	}
+
+	if got, ok := morning.Union(afternoon); !ok || !got.IsEqual(must(IntervalOf(utcAt(1, 9), utcAt(1, 17)))) {
+		t.Errorf("Union of abutting intervals = %v, %v", got, ok)
+	}
+	if _, ok := morning.Union(evening); ok {
+		t.Error("Union of disjoint intervals should fail")
+	}
+
+	if got, ok := afternoon.Gap(evening); !ok || !got.IsEqual(must(IntervalOf(utcAt(1, 17), utcAt(1, 19)))) {
+		t.Errorf("Gap = %v, %v", got, ok)
+	}
+	if got, ok := evening.Gap(afternoon); !ok || got.Duration() != 2*time.Hour {
+		t.Errorf("Gap in reverse order = %v, %v", got, ok)
+	}
+	if _, ok := morning.Gap(afternoon); ok {
+		t.Error("abutting intervals have no gap")
+	}
+
+	if got := morning.Span(evening); !got.IsEqual(must(IntervalOf(utcAt(1, 9), utcAt(1, 22)))) {
+		t.Errorf("Span = %v", got)
+	}
+}
+
+func TestIntervalConstructors(t *testing.T) {
+	loc := loadNewYork(t)
+	// 2024-03-10 is 23 hours long in New York
+	gdt := Create(time.Date(2024, 3, 10, 15, 0, 0, 0, loc))
+	day := DayOf(gdt)
+	if day.Start.ToDateTimeString() != "2024-03-10 00:00:00" || day.End.ToDateTimeString() != "2024-03-11 00:00:00" || day.Duration() != 23*time.Hour {
+		t.Errorf("DayOf = %v, duration %v", day, day.Duration())
+	}
+	if !day.Abuts(DayOf(gdt.PlusDays(1))) {
+		t.Error("consecutive days should abut")
+	}
+
+	// 2024-03-10 is a Sunday
+	week := WeekOf(gdt, time.Monday)
+	if week.Start.ToDateString() != "2024-03-04" || week.End.ToDateString() != "2024-03-11" {
+		t.Errorf("WeekOf Monday = %v", week)
+	}
+	week = WeekOf(gdt, time.Sunday)
+	if week.Start.ToDateString() != "2024-03-10" || week.End.ToDateString() != "2024-03-17" {
+		t.Errorf("WeekOf Sunday = %v", week)
+	}
+
+	month := MonthOf(Create(time.Date(2024, 12, 31, 23, 0, 0, 0, time.UTC)))
+	if month.String() != "2024-12-01T00:00:00Z/2025-01-01T00:00:00Z" {
+		t.Errorf("MonthOf = %v", month)
+	}
+}
+
+func TestParseInterval(t *testing.T) {
+	tests := []struct {
+		input, want string
+	}{
+		{"2024-06-01T00:00:00Z/2024-07-01T00:00:00Z", "2024-06-01T00:00:00Z/2024-07-01T00:00:00Z"},
+		{"2024-06-01T00:00:00Z/P1M", "2024-06-01T00:00:00Z/2024-07-01T00:00:00Z"},
+		{"2024-01-31T00:00:00+08:00/P1M", "2024-01-31T00:00:00+08:00/2024-02-29T00:00:00+08:00"},
+		{"P1DT12H/2024-06-03T12:00:00Z", "2024-06-02T00:00:00Z/2024-06-03T12:00:00Z"},
+		{"2024-06-01T09:00:00.5Z/PT30M", "2024-06-01T09:00:00.5Z/2024-06-01T09:30:00.5Z"},
+	}
+	for _, test := range tests {
+		i, err := ParseInterval(test.input)
+		if err != nil {
+			t.Errorf("ParseInterval(%q) failed: %v", test.input, err)
+			continue
+		}
+		if i.String() != test.want {
+			t.Errorf("ParseInterval(%q) = %s, want %s", test.input, i, test.want)
+		}
+	}
+
+	for _, input := range []string{
+		"2024-06-01T00:00:00Z",
+		"P1D/P2D",
+		"2024-06-01/P1D",
+		"2024-06-01T00:00:00Z/PX",
+		"2024-06-02T00:00:00Z/2024-06-01T00:00:00Z",
+		"2024-06-01T00:00:00Z/2024-06-02T00:00:00Z/2024-06-03T00:00:00Z",
+	} {
+		_, err := ParseInterval(input)
+		var parseErr *ParseError
+		if !errors.As(err, &parseErr) {
+			t.Errorf("ParseInterval(%q) should fail with a *ParseError, got %v", input, err)
+		}
+	}
+}
+
+func TestIntervalStringWithDuration(t *testing.T) {
+	tests := []struct {
+		start, end *GDateTime
+		want       string
+	}{
+		{utcAt(1, 0), Create(time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)), "2024-06-01T00:00:00Z/P1M"},
+		{utcAt(1, 10), utcAt(3, 9), "2024-06-01T10:00:00Z/P1DT23H"},
+		{utcAt(1, 10), utcAt(1, 10), "2024-06-01T10:00:00Z/PT0S"},
+		{Create(time.Date(2024, 1, 31, 10, 0, 0, 0, time.UTC)), Create(time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)), "2024-01-31T10:00:00Z/P29DT23H"},
+	}
+	for _, test := range tests {
+		i := must(IntervalOf(test.start, test.end))
+		got := i.StringWithDuration()
+		if got != test.want {
+			t.Errorf("StringWithDuration = %s, want %s", got, test.want)
+		}
+		parsed, err := ParseInterval(got)
+		if err != nil || !parsed.IsEqual(i) {
+			t.Errorf("ParseInterval(%s) = %v, %v, want %v", got, parsed, err, i)
+		}
+	}
+}
+
+func TestIntervalJSON(t *testing.T) {
+	i := must(IntervalOf(utcAt(1, 9), utcAt(1, 17)))
+	data, err := json.Marshal(i)
+	if err != nil || string(data) != `"2024-06-01T09:00:00Z/2024-06-01T17:00:00Z"` {
+		t.Fatalf("json.Marshal = %s, %v", data, err)
+	}
+	var decoded Interval
+	if err := json.Unmarshal(data, &decoded); err != nil || !decoded.IsEqual(i) {
+		t.Errorf("json.Unmarshal = %v, %v", decoded, err)
+	}
+	if _, err := json.Marshal(Interval{}); !errors.Is(err, ErrInvalidInterval) {
+		t.Errorf("json.Marshal of a zero Interval should fail with ErrInvalidInterval, got %v", err)
+	}
+}
@@ -0,0 +1,300 @@
+package gdatetime
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/linsongze/go-date-time/datetime/chronofield"
+	"github.com/linsongze/go-date-time/datetime/timeconst"
+)
+
+// LocalDate is a date without a time of day or a location, such as a birthday.
+// It is a value type, comparable with ==, and is not shifted by a change of zone.
+type LocalDate struct {
+	year  int
+	month int
+	day   int
+}
+
+// localDateLayout is the ISO 8601 layout used by String and ParseLocalDate.
+const localDateLayout = "2006-01-02"
+
+// LocalDateOf Obtains a LocalDate from year, month and day, every field is validated strictly
+func LocalDateOf(year, month, dayOfMonth int) (LocalDate, error) {
+	if err := checkDateFields(year, month, dayOfMonth); err != nil {
+		return LocalDate{}, err
+	}
+	return LocalDate{year: year, month: month, day: dayOfMonth}, nil
+}
+
+// LocalDateOfEpochDay Obtains a LocalDate from the number of days since 1970-01-01
+func LocalDateOfEpochDay(epochDay int64) LocalDate {
+	return localDateOf(time.Unix(epochDay*timeconst.SECONDS_PER_DAY, 0).UTC())
+}
+
+// ParseLocalDate parses an ISO 8601 date such as "2024-06-03", a year beyond 9999 or before 0 is written
+// with more digits or a sign, such as "12345-01-02" or "-0005-01-02". A failure is returned as a *ParseError
+func ParseLocalDate(s string) (LocalDate, error) {
+	year, t, err := parseYearPrefixed(s, localDateLayout)
+	if err != nil {
+		return LocalDate{}, err
+	}
+	d := localDateOf(t)
+	d.year = year
+	if err := checkDateFields(d.year, d.month, d.day); err != nil {
+		return LocalDate{}, &ParseError{Input: s, Layout: localDateLayout, Offset: -1, Reason: "invalid date", Err: err}
+	}
+	return d, nil
+}
+
+// isoYearPattern matches the year at the start of an ISO 8601 date: four digits or more with an optional sign,
+// the way time.Time.Format writes years after 9999 and before 0.
+var isoYearPattern = regexp.MustCompile(`^[-+]?[0-9]{4,}`)
+
+// parseYearPrefixed parses s with a layout starting with "2006", reading the year with isoYearPattern
+// and the rest with time.Parse. The returned time is in year 0, the caller checks the day of month against the year.
+func parseYearPrefixed(s, layout string) (int, time.Time, error) {
+	prefix := isoYearPattern.FindString(s)
+	if prefix == "" {
+		return 0, time.Time{}, &ParseError{Input: s, Layout: layout, Offset: 0, Reason: "invalid year"}
+	}
+	year, err := strconv.Atoi(prefix)
+	if err == nil {
+		err = checkYear(year)
+	}
+	if err != nil {
+		return 0, time.Time{}, &ParseError{Input: s, Layout: layout, Offset: 0, Reason: "invalid year", Err: err}
+	}
+	t, err := time.Parse(layout[len("2006"):], s[len(prefix):])
+	if err != nil {
+		return 0, time.Time{}, newParseError(s, layout, err)
+	}
+	return year, t, nil
+}
+
+// localDateOf returns the date of the wall clock of t.
+func localDateOf(t time.Time) LocalDate {
+	return LocalDate{year: t.Year(), month: int(t.Month()), day: t.Day()}
+}
+
+// ToLocalDate returns the date of the GDateTime on its own wall clock, dropping the time of day and the location.
+func (gdt *GDateTime) ToLocalDate() LocalDate {
+	return localDateOf(gdt.t)
+}
+
+// toTime returns the date as midnight UTC, used for arithmetic and formatting.
+func (d LocalDate) toTime() time.Time {
+	return time.Date(d.year, time.Month(d.month), d.day, 0, 0, 0, 0, time.UTC)
+}
+
+// GetYear returns the year of the LocalDate.
+func (d LocalDate) GetYear() int {
+	return d.year
+}
+
+// GetMonth returns the month of the LocalDate as an integer (1-12).
+func (d LocalDate) GetMonth() int {
+	return d.month
+}
+
+// GetDayOfMonth returns the day of the month of the LocalDate.
+func (d LocalDate) GetDayOfMonth() int {
+	return d.day
+}
+
+// GetDayOfYear returns the day of the year of the LocalDate.
+func (d LocalDate) GetDayOfYear() int {
+	return d.toTime().YearDay()
+}
+
+// GetDayOfWeek returns the day of the week of the LocalDate, Sunday = 0, Monday = 1, ..., Saturday = 6.
+func (d LocalDate) GetDayOfWeek() int {
+	return int(d.toTime().Weekday())
+}
+
+// LengthOfMonth returns the number of days in the month of the LocalDate.
+func (d LocalDate) LengthOfMonth() int {
+	return DaysInMonth(d.year, d.month)
+}
+
+// IsLeapYear checks if the year of the LocalDate is a leap year.
+func (d LocalDate) IsLeapYear() bool {
+	return isLeapYear(d.year)
+}
+
+// EpochDay returns the number of days from 1970-01-01 to the LocalDate.
+func (d LocalDate) EpochDay() int64 {
+	return epochDay(d.year, d.month, d.day)
+}
+
+// WithYear Returns a copy of this LocalDate with the year altered, Feb 29 is clamped to Feb 28 in a non-leap year.
+func (d LocalDate) WithYear(year int) (LocalDate, error) {
+	if err := checkField(chronofield.YEAR, year, timeconst.MIN_YEAR, timeconst.MAX_YEAR); err != nil {
+		return LocalDate{}, err
+	}
+	day, _ := resolveDayOfMonth(d.year, d.month, d.day, year, d.month, OverflowClamp)
+	return LocalDate{year: year, month: d.month, day: day}, nil
+}
+
+// WithMonth Returns a copy of this LocalDate with the month altered, the day is clamped to the end of the new month.
+func (d LocalDate) WithMonth(month int) (LocalDate, error) {
+	if err := checkField(chronofield.MONTH_OF_YEAR, month, 1, 12); err != nil {
+		return LocalDate{}, err
+	}
+	day, _ := resolveDayOfMonth(d.year, d.month, d.day, d.year, month, OverflowClamp)
+	return LocalDate{year: d.year, month: month, day: day}, nil
+}
+
+// WithDayOfMonth Returns a copy of this LocalDate with the day-of-month altered.
+func (d LocalDate) WithDayOfMonth(day int) (LocalDate, error) {
+	if err := checkField(chronofield.DAY_OF_MONTH, day, 1, d.LengthOfMonth()); err != nil {
+		return LocalDate{}, err
+	}
+	return LocalDate{year: d.year, month: d.month, day: day}, nil
+}
+
+// WithDayOfYear Returns a copy of this LocalDate with the day-of-year altered.
+func (d LocalDate) WithDayOfYear(day int) (LocalDate, error) {
+	if err := checkField(chronofield.DAY_OF_YEAR, day, 1, daysInYear(d.year)); err != nil {
+		return LocalDate{}, err
+	}
+	return localDateOf(time.Date(d.year, time.January, day, 0, 0, 0, 0, time.UTC)), nil
+}
+
+// PlusYears adds years to the LocalDate, Feb 29 is clamped to Feb 28 in a non-leap year.
+func (d LocalDate) PlusYears(years int) LocalDate {
+	return d.PlusMonths(years * 12)
+}
+
+// PlusMonths adds months to the LocalDate, the day is clamped to the end of the resulting month, so Jan 31 + 1 month is Feb 28/29.
+func (d LocalDate) PlusMonths(months int) LocalDate {
+	year, month, day := plusMonthsClamped(d.year, d.month, d.day, months)
+	return LocalDate{year: year, month: month, day: day}
+}
+
+// PlusWeeks adds weeks to the LocalDate.
+func (d LocalDate) PlusWeeks(weeks int) LocalDate {
+	return d.PlusDays(weeks * 7)
+}
+
+// PlusDays adds days to the LocalDate.
+func (d LocalDate) PlusDays(days int) LocalDate {
+	return localDateOf(d.toTime().AddDate(0, 0, days))
+}
+
+// PlusPeriod adds the Period to the LocalDate, months first with the day clamped, then days.
+func (d LocalDate) PlusPeriod(p Period) LocalDate {
+	return d.PlusMonths(p.TotalMonths()).PlusDays(p.Days)
+}
+
+// MinusYears subtracts years from the LocalDate.
+func (d LocalDate) MinusYears(years int) LocalDate {
+	return d.PlusYears(-years)
+}
+
+// MinusMonths subtracts months from the LocalDate.
+func (d LocalDate) MinusMonths(months int) LocalDate {
+	return d.PlusMonths(-months)
+}
+
+// MinusWeeks subtracts weeks from the LocalDate.
+func (d LocalDate) MinusWeeks(weeks int) LocalDate {
+	return d.PlusWeeks(-weeks)
+}
+
+// MinusDays subtracts days from the LocalDate.
+func (d LocalDate) MinusDays(days int) LocalDate {
+	return d.PlusDays(-days)
+}
+
+// MinusPeriod subtracts the Period from the LocalDate.
+func (d LocalDate) MinusPeriod(p Period) LocalDate {
+	return d.PlusPeriod(p.Negated())
+}
+
+// CompareTo compares this LocalDate with another, returning -1, 0 or 1.
+func (d LocalDate) CompareTo(other LocalDate) int {
+	if c := compareInt(d.year, other.year); c != 0 {
+		return c
+	}
+	if c := compareInt(d.month, other.month); c != 0 {
+		return c
+	}
+	return compareInt(d.day, other.day)
+}
+
+// IsBefore checks if this LocalDate is before the other.
+func (d LocalDate) IsBefore(other LocalDate) bool {
+	return d.CompareTo(other) < 0
+}
+
+// IsAfter checks if this LocalDate is after the other.
+func (d LocalDate) IsAfter(other LocalDate) bool {
+	return d.CompareTo(other) > 0
+}
+
+// IsEqual checks if this LocalDate is the same date as the other.
+func (d LocalDate) IsEqual(other LocalDate) bool {
+	return d == other
+}
+
+// DaysUntil returns the number of days from this LocalDate to the end LocalDate, negative if end is before.
+func (d LocalDate) DaysUntil(end LocalDate) int {
+	return int(end.EpochDay() - d.EpochDay())
+}
+
+// AtTime combines the LocalDate with a LocalTime to create a LocalDateTime.
+func (d LocalDate) AtTime(t LocalTime) LocalDateTime {
+	return LocalDateTime{date: d, time: t}
+}
+
+// AtStartOfDay returns the GDateTime at midnight of the LocalDate in the given location.
+func (d LocalDate) AtStartOfDay(loc *time.Location) (*GDateTime, error) {
+	return d.AtTime(LocalTime{}).AtZone(loc)
+}
+
+// ToFormatString formats the LocalDate based on time package layout specifier.
+func (d LocalDate) ToFormatString(layout string) string {
+	return d.toTime().Format(layout)
+}
+
+// Strftime formats the LocalDate with a C style format, time directives give midnight and %z, %Z are empty.
+func (d LocalDate) Strftime(f string) string {
+	t := d.toTime()
+	return strftime(&t, f, false)
+}
+
+// String returns the LocalDate in ISO 8601 format yyyy-MM-dd.
+func (d LocalDate) String() string {
+	return d.ToFormatString(localDateLayout)
+}
+
+// MarshalText implements encoding.TextMarshaler using the ISO 8601 format.
+func (d LocalDate) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the ISO 8601 format.
+func (d *LocalDate) UnmarshalText(text []byte) error {
+	if d == nil {
+		return errors.New("UnmarshalText on nil pointer")
+	}
+	parsed, err := ParseLocalDate(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// compareInt compares two ints, returning -1, 0 or 1.
+func compareInt(a, b int) int {
+	if a < b {
+		return -1
+	} else if a > b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,152 @@
+package gdatetime
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/linsongze/go-date-time/datetime/chronofield"
+)
+
+func TestLocalDateOf(t *testing.T) {
+	d := must(LocalDateOf(2024, 2, 29))
+	if d.GetYear() != 2024 || d.GetMonth() != 2 || d.GetDayOfMonth() != 29 {
+		t.Errorf("LocalDateOf failed, got %v", d)
+	}
+	if d.GetDayOfYear() != 60 || d.GetDayOfWeek() != 4 || d.LengthOfMonth() != 29 || !d.IsLeapYear() {
+		t.Errorf("LocalDate getters failed for %v", d)
+	}
+
+	_, err := LocalDateOf(2023, 2, 29)
+	var rangeErr *FieldRangeError
+	if !errors.As(err, &rangeErr) || rangeErr.Field != chronofield.DAY_OF_MONTH {
+		t.Errorf("LocalDateOf(2023-02-29) should fail on DayOfMonth, got %v", err)
+	}
+}
+
+func TestLocalDateEpochDay(t *testing.T) {
+	d := must(LocalDateOf(2024, 6, 3))
+	if d.EpochDay() != 19877 {
+		t.Errorf("EpochDay failed, expected 19877, got %d", d.EpochDay())
+	}
+	if got := LocalDateOfEpochDay(19877); got != d {
+		t.Errorf("LocalDateOfEpochDay failed, expected %v, got %v", d, got)
+	}
+	if got := LocalDateOfEpochDay(-1); got != must(LocalDateOf(1969, 12, 31)) {
+		t.Errorf("LocalDateOfEpochDay(-1) failed, got %v", got)
+	}
+}
+
+func TestLocalDateWith(t *testing.T) {
+	d := must(LocalDateOf(2024, 1, 31))
+	if got, _ := d.WithMonth(2); got != must(LocalDateOf(2024, 2, 29)) {
+		t.Errorf("WithMonth should clamp the day, got %v", got)
+	}
+	if got, _ := must(LocalDateOf(2024, 2, 29)).WithYear(2023); got != must(LocalDateOf(2023, 2, 28)) {
+		t.Errorf("WithYear should clamp Feb 29, got %v", got)
+	}
+	if got, _ := d.WithDayOfYear(366); got != must(LocalDateOf(2024, 12, 31)) {
+		t.Errorf("WithDayOfYear failed, got %v", got)
+	}
+	if _, err := d.WithDayOfMonth(32); err == nil {
+		t.Error("WithDayOfMonth(32) should fail")
+	}
+	if _, err := d.WithMonth(0); err == nil {
+		t.Error("WithMonth(0) should fail")
+	}
+}
+
+func TestLocalDatePlus(t *testing.T) {
+	d := must(LocalDateOf(2024, 1, 31))
+	cases := []struct {
+		name     string
+		got      LocalDate
+		expected LocalDate
+	}{
+		{"PlusMonths", d.PlusMonths(1), must(LocalDateOf(2024, 2, 29))},
+		{"MinusMonths", d.MinusMonths(2), must(LocalDateOf(2023, 11, 30))},
+		{"PlusYears", must(LocalDateOf(2024, 2, 29)).PlusYears(1), must(LocalDateOf(2025, 2, 28))},
+		{"PlusWeeks", d.PlusWeeks(1), must(LocalDateOf(2024, 2, 7))},
+		{"MinusDays", d.MinusDays(31), must(LocalDateOf(2023, 12, 31))},
+		{"PlusPeriod", d.PlusPeriod(PeriodOf(0, 1, 1)), must(LocalDateOf(2024, 3, 1))},
+	}
+	for _, c := range cases {
+		if c.got != c.expected {
+			t.Errorf("%s failed, expected %v, got %v", c.name, c.expected, c.got)
+		}
+	}
+}
+
+func TestLocalDateCompare(t *testing.T) {
+	a := must(LocalDateOf(2024, 6, 3))
+	b := must(LocalDateOf(2024, 6, 4))
+	if !a.IsBefore(b) || !b.IsAfter(a) || a.CompareTo(b) != -1 || !a.IsEqual(a) {
+		t.Errorf("LocalDate comparison failed")
+	}
+	if a.DaysUntil(b) != 1 || b.DaysUntil(a) != -1 {
+		t.Errorf("DaysUntil failed")
+	}
+}
+
+func TestLocalDateFormatAndParse(t *testing.T) {
+	d := must(LocalDateOf(2024, 6, 3))
+	if d.String() != "2024-06-03" {
+		t.Errorf("String failed, got %s", d.String())
+	}
+	if got := d.Strftime("%Y/%m/%d %H:%M %z%Z"); got != "2024/06/03 00:00 " {
+		t.Errorf("Strftime failed, got %q", got)
+	}
+	if got := d.ToFormatString("Jan 2, 2006"); got != "Jun 3, 2024" {
+		t.Errorf("ToFormatString failed, got %s", got)
+	}
+
+	parsed, err := ParseLocalDate("2024-06-03")
+	if err != nil || parsed != d {
+		t.Errorf("ParseLocalDate failed, got %v, %v", parsed, err)
+	}
+	if _, err := ParseLocalDate("2024-02-30"); !errors.Is(err, ErrParse) {
+		t.Errorf("ParseLocalDate(2024-02-30) should fail with ErrParse, got %v", err)
+	}
+
+	data, _ := json.Marshal(struct{ Birthday LocalDate }{d})
+	if string(data) != `{"Birthday":"2024-06-03"}` {
+		t.Errorf("json.Marshal failed, got %s", data)
+	}
+	var decoded struct{ Birthday LocalDate }
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded.Birthday != d {
+		t.Errorf("json.Unmarshal failed, got %v, %v", decoded.Birthday, err)
+	}
+}
+
+func TestToLocalDateIgnoresZoneChange(t *testing.T) {
+	shanghai := time.FixedZone("CST", 8*3600)
+	gdt := Create(time.Date(2024, 6, 3, 1, 0, 0, 0, shanghai))
+	if got := gdt.ToLocalDate(); got != must(LocalDateOf(2024, 6, 3)) {
+		t.Errorf("ToLocalDate failed, got %v", got)
+	}
+	if got := gdt.ResetZoneToDefault().InLocation(time.UTC).ToLocalDate(); got != must(LocalDateOf(2024, 6, 2)) {
+		t.Errorf("ToLocalDate after a zone change should follow the new wall clock, got %v", got)
+	}
+
+	start, err := must(LocalDateOf(2024, 6, 3)).AtStartOfDay(shanghai)
+	if err != nil || !start.t.Equal(time.Date(2024, 6, 3, 0, 0, 0, 0, shanghai)) {
+		t.Errorf("AtStartOfDay failed, got %v, %v", start, err)
+	}
+}
+
+func TestLocalDateTextRoundTripBeyondFourDigits(t *testing.T) {
+	for _, d := range []LocalDate{must(LocalDateOf(12345, 1, 2)), must(LocalDateOf(-5, 1, 2)), must(LocalDateOf(0, 2, 29)), must(LocalDateOf(-12345, 12, 31))} {
+		text, _ := d.MarshalText()
+		var got LocalDate
+		if err := got.UnmarshalText(text); err != nil || got != d {
+			t.Errorf("LocalDate %s does not round trip, got %v, %v", text, got, err)
+		}
+	}
+	if _, err := ParseLocalDate("-0005-02-29"); !errors.Is(err, ErrFieldRange) {
+		t.Errorf("ParseLocalDate(-0005-02-29) should fail with ErrFieldRange, got %v", err)
+	}
+	if _, err := ParseLocalDate("1000000000-01-01"); !errors.Is(err, ErrParse) {
+		t.Errorf("ParseLocalDate should reject a year beyond timeconst.MAX_YEAR, got %v", err)
+	}
+}
@@ -0,0 +1,349 @@
+package gdatetime
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// LocalDateTime is a date and time of day without a location, such as "2024-06-03 09:00" on a wall calendar.
+// It is a value type, comparable with ==, and AtZone places it on the time line of a location.
+type LocalDateTime struct {
+	date LocalDate
+	time LocalTime
+}
+
+// localDateTimeLayout is the ISO 8601 layout used by String, the fraction is omitted when zero.
+const localDateTimeLayout = "2006-01-02T15:04:05.999999999"
+
+// LocalDateTimeOf Obtains a LocalDateTime from year, month, day, hour, minute, second and nanosecond, every field is validated strictly
+func LocalDateTimeOf(year, month, dayOfMonth, hour, minute, second, nanoOfSecond int) (LocalDateTime, error) {
+	date, err := LocalDateOf(year, month, dayOfMonth)
+	if err != nil {
+		return LocalDateTime{}, err
+	}
+	t, err := LocalTimeOf(hour, minute, second, nanoOfSecond)
+	if err != nil {
+		return LocalDateTime{}, err
+	}
+	return LocalDateTime{date: date, time: t}, nil
+}
+
+// ParseLocalDateTime parses an ISO 8601 date-time such as "2024-06-03T09:00:00", a space is also accepted between date and time.
+// The seconds and the fraction of a second are optional. A failure is returned as a *ParseError.
+func ParseLocalDateTime(s string) (LocalDateTime, error) {
+	// the date ends after the month and day that follow a year of four digits or more
+	dateEnd := len(isoYearPattern.FindString(s)) + len("-01-02")
+	layout := "2006-01-02T15:04:05"
+	if len(s) == dateEnd+len("T15:04") {
+		layout = "2006-01-02T15:04"
+	}
+	if len(s) > dateEnd && s[dateEnd] == ' ' {
+		layout = strings.Replace(layout, "T", " ", 1)
+	}
+	year, t, err := parseYearPrefixed(s, layout)
+	if err != nil {
+		return LocalDateTime{}, err
+	}
+	ldt := localDateTimeOf(t)
+	ldt.date.year = year
+	if err := checkDateFields(ldt.date.year, ldt.date.month, ldt.date.day); err != nil {
+		return LocalDateTime{}, &ParseError{Input: s, Layout: layout, Offset: -1, Reason: "invalid date", Err: err}
+	}
+	return ldt, nil
+}
+
+// localDateTimeOf returns the date and time of day of the wall clock of t.
+func localDateTimeOf(t time.Time) LocalDateTime {
+	return LocalDateTime{date: localDateOf(t), time: localTimeOf(t)}
+}
+
+// ToLocalDateTime returns the wall clock date and time of the GDateTime, dropping the location.
+func (gdt *GDateTime) ToLocalDateTime() LocalDateTime {
+	return localDateTimeOf(gdt.t)
+}
+
+// toTime returns the LocalDateTime as a wall clock time in UTC, used for formatting.
+func (ldt LocalDateTime) toTime() time.Time {
+	d, t := ldt.date, ldt.time
+	return time.Date(d.year, time.Month(d.month), d.day, t.hour, t.minute, t.second, t.nano, time.UTC)
+}
+
+// AtZone places the LocalDateTime in the given location.
+// A wall clock time that is skipped or repeated by a DST change is resolved the way time.Date does.
+func (ldt LocalDateTime) AtZone(loc *time.Location) (*GDateTime, error) {
+	if loc == nil {
+		return nil, ErrNilLocation
+	}
+	d, t := ldt.date, ldt.time
+	return Create(time.Date(d.year, time.Month(d.month), d.day, t.hour, t.minute, t.second, t.nano, loc)), nil
+}
+
+// ToLocalDate returns the date part of the LocalDateTime.
+func (ldt LocalDateTime) ToLocalDate() LocalDate {
+	return ldt.date
+}
+
+// ToLocalTime returns the time of day part of the LocalDateTime.
+func (ldt LocalDateTime) ToLocalTime() LocalTime {
+	return ldt.time
+}
+
+// GetYear returns the year of the LocalDateTime.
+func (ldt LocalDateTime) GetYear() int {
+	return ldt.date.year
+}
+
+// GetMonth returns the month of the LocalDateTime as an integer (1-12).
+func (ldt LocalDateTime) GetMonth() int {
+	return ldt.date.month
+}
+
+// GetDayOfMonth returns the day of the month of the LocalDateTime.
+func (ldt LocalDateTime) GetDayOfMonth() int {
+	return ldt.date.day
+}
+
+// GetDayOfYear returns the day of the year of the LocalDateTime.
+func (ldt LocalDateTime) GetDayOfYear() int {
+	return ldt.date.GetDayOfYear()
+}
+
+// GetDayOfWeek returns the day of the week of the LocalDateTime, Sunday = 0, Monday = 1, ..., Saturday = 6.
+func (ldt LocalDateTime) GetDayOfWeek() int {
+	return ldt.date.GetDayOfWeek()
+}
+
+// GetHour returns the hour of the LocalDateTime.
+func (ldt LocalDateTime) GetHour() int {
+	return ldt.time.hour
+}
+
+// GetMinute returns the minute of the LocalDateTime.
+func (ldt LocalDateTime) GetMinute() int {
+	return ldt.time.minute
+}
+
+// GetSecond returns the second of the LocalDateTime.
+func (ldt LocalDateTime) GetSecond() int {
+	return ldt.time.second
+}
+
+// GetNano returns the nanosecond of the LocalDateTime.
+func (ldt LocalDateTime) GetNano() int {
+	return ldt.time.nano
+}
+
+// withDate returns the LocalDateTime with the date replaced, passing through an error.
+func (ldt LocalDateTime) withDate(date LocalDate, err error) (LocalDateTime, error) {
+	if err != nil {
+		return LocalDateTime{}, err
+	}
+	return LocalDateTime{date: date, time: ldt.time}, nil
+}
+
+// withTime returns the LocalDateTime with the time of day replaced, passing through an error.
+func (ldt LocalDateTime) withTime(t LocalTime, err error) (LocalDateTime, error) {
+	if err != nil {
+		return LocalDateTime{}, err
+	}
+	return LocalDateTime{date: ldt.date, time: t}, nil
+}
+
+// WithYear Returns a copy of this LocalDateTime with the year altered, Feb 29 is clamped to Feb 28 in a non-leap year.
+func (ldt LocalDateTime) WithYear(year int) (LocalDateTime, error) {
+	return ldt.withDate(ldt.date.WithYear(year))
+}
+
+// WithMonth Returns a copy of this LocalDateTime with the month altered, the day is clamped to the end of the new month.
+func (ldt LocalDateTime) WithMonth(month int) (LocalDateTime, error) {
+	return ldt.withDate(ldt.date.WithMonth(month))
+}
+
+// WithDayOfMonth Returns a copy of this LocalDateTime with the day-of-month altered.
+func (ldt LocalDateTime) WithDayOfMonth(day int) (LocalDateTime, error) {
+	return ldt.withDate(ldt.date.WithDayOfMonth(day))
+}
+
+// WithDayOfYear Returns a copy of this LocalDateTime with the day-of-year altered.
+func (ldt LocalDateTime) WithDayOfYear(day int) (LocalDateTime, error) {
+	return ldt.withDate(ldt.date.WithDayOfYear(day))
+}
+
+// WithHour Returns a copy of this LocalDateTime with the hour-of-day altered.
+func (ldt LocalDateTime) WithHour(hour int) (LocalDateTime, error) {
+	return ldt.withTime(ldt.time.WithHour(hour))
+}
+
+// WithMinute Returns a copy of this LocalDateTime with the minute altered.
+func (ldt LocalDateTime) WithMinute(minute int) (LocalDateTime, error) {
+	return ldt.withTime(ldt.time.WithMinute(minute))
+}
+
+// WithSecond Returns a copy of this LocalDateTime with the second altered.
+func (ldt LocalDateTime) WithSecond(second int) (LocalDateTime, error) {
+	return ldt.withTime(ldt.time.WithSecond(second))
+}
+
+// WithNano Returns a copy of this LocalDateTime with the nanosecond altered.
+func (ldt LocalDateTime) WithNano(nano int) (LocalDateTime, error) {
+	return ldt.withTime(ldt.time.WithNano(nano))
+}
+
+// PlusYears adds years to the LocalDateTime, Feb 29 is clamped to Feb 28 in a non-leap year.
+func (ldt LocalDateTime) PlusYears(years int) LocalDateTime {
+	return LocalDateTime{date: ldt.date.PlusYears(years), time: ldt.time}
+}
+
+// PlusMonths adds months to the LocalDateTime, the day is clamped to the end of the resulting month.
+func (ldt LocalDateTime) PlusMonths(months int) LocalDateTime {
+	return LocalDateTime{date: ldt.date.PlusMonths(months), time: ldt.time}
+}
+
+// PlusWeeks adds weeks to the LocalDateTime.
+func (ldt LocalDateTime) PlusWeeks(weeks int) LocalDateTime {
+	return LocalDateTime{date: ldt.date.PlusWeeks(weeks), time: ldt.time}
+}
+
+// PlusDays adds days to the LocalDateTime.
+func (ldt LocalDateTime) PlusDays(days int) LocalDateTime {
+	return LocalDateTime{date: ldt.date.PlusDays(days), time: ldt.time}
+}
+
+// PlusHours adds hours to the LocalDateTime, carrying into the date. There is no DST, every day has 24 hours.
+func (ldt LocalDateTime) PlusHours(hours int) LocalDateTime {
+	return ldt.plusTime(hours, 0, 0, 0)
+}
+
+// PlusMinutes adds minutes to the LocalDateTime, carrying into the date.
+func (ldt LocalDateTime) PlusMinutes(minutes int) LocalDateTime {
+	return ldt.plusTime(0, minutes, 0, 0)
+}
+
+// PlusSeconds adds seconds to the LocalDateTime, carrying into the date.
+func (ldt LocalDateTime) PlusSeconds(seconds int) LocalDateTime {
+	return ldt.plusTime(0, 0, seconds, 0)
+}
+
+// PlusNanos adds nanoseconds to the LocalDateTime, carrying into the date.
+func (ldt LocalDateTime) PlusNanos(nanos int) LocalDateTime {
+	return ldt.plusTime(0, 0, 0, nanos)
+}
+
+// PlusPeriod adds the Period to the LocalDateTime, months first with the day clamped, then days.
+func (ldt LocalDateTime) PlusPeriod(p Period) LocalDateTime {
+	return LocalDateTime{date: ldt.date.PlusPeriod(p), time: ldt.time}
+}
+
+// plusTime adds the time amounts and moves the date by the number of days crossed.
+func (ldt LocalDateTime) plusTime(hours, minutes, seconds, nanos int) LocalDateTime {
+	t, days := ldt.time.plusWithOverflow(hours, minutes, seconds, nanos)
+	return LocalDateTime{date: ldt.date.PlusDays(days), time: t}
+}
+
+// MinusYears subtracts years from the LocalDateTime.
+func (ldt LocalDateTime) MinusYears(years int) LocalDateTime {
+	return ldt.PlusYears(-years)
+}
+
+// MinusMonths subtracts months from the LocalDateTime.
+func (ldt LocalDateTime) MinusMonths(months int) LocalDateTime {
+	return ldt.PlusMonths(-months)
+}
+
+// MinusWeeks subtracts weeks from the LocalDateTime.
+func (ldt LocalDateTime) MinusWeeks(weeks int) LocalDateTime {
+	return ldt.PlusWeeks(-weeks)
+}
+
+// MinusDays subtracts days from the LocalDateTime.
+func (ldt LocalDateTime) MinusDays(days int) LocalDateTime {
+	return ldt.PlusDays(-days)
+}
+
+// MinusHours subtracts hours from the LocalDateTime.
+func (ldt LocalDateTime) MinusHours(hours int) LocalDateTime {
+	return ldt.PlusHours(-hours)
+}
+
+// MinusMinutes subtracts minutes from the LocalDateTime.
+func (ldt LocalDateTime) MinusMinutes(minutes int) LocalDateTime {
+	return ldt.PlusMinutes(-minutes)
+}
+
+// MinusSeconds subtracts seconds from the LocalDateTime.
+func (ldt LocalDateTime) MinusSeconds(seconds int) LocalDateTime {
+	return ldt.PlusSeconds(-seconds)
+}
+
+// MinusNanos subtracts nanoseconds from the LocalDateTime.
+func (ldt LocalDateTime) MinusNanos(nanos int) LocalDateTime {
+	return ldt.PlusNanos(-nanos)
+}
+
+// MinusPeriod subtracts the Period from the LocalDateTime.
+func (ldt LocalDateTime) MinusPeriod(p Period) LocalDateTime {
+	return ldt.PlusPeriod(p.Negated())
+}
+
+// CompareTo compares this LocalDateTime with another, returning -1, 0 or 1.
+func (ldt LocalDateTime) CompareTo(other LocalDateTime) int {
+	if c := ldt.date.CompareTo(other.date); c != 0 {
+		return c
+	}
+	return ldt.time.CompareTo(other.time)
+}
+
+// IsBefore checks if this LocalDateTime is before the other.
+func (ldt LocalDateTime) IsBefore(other LocalDateTime) bool {
+	return ldt.CompareTo(other) < 0
+}
+
+// IsAfter checks if this LocalDateTime is after the other.
+func (ldt LocalDateTime) IsAfter(other LocalDateTime) bool {
+	return ldt.CompareTo(other) > 0
+}
+
+// IsEqual checks if this LocalDateTime is the same date and time as the other.
+func (ldt LocalDateTime) IsEqual(other LocalDateTime) bool {
+	return ldt == other
+}
+
+// ToFormatString formats the LocalDateTime based on time package layout specifier.
+func (ldt LocalDateTime) ToFormatString(layout string) string {
+	return ldt.toTime().Format(layout)
+}
+
+// Strftime formats the LocalDateTime with a C style format, %z and %Z are empty.
+func (ldt LocalDateTime) Strftime(f string) string {
+	t := ldt.toTime()
+	return strftime(&t, f, false)
+}
+
+// ToDateTimeString return format yyyy-MM-dd HH:mm:ss
+func (ldt LocalDateTime) ToDateTimeString() string {
+	return ldt.ToFormatString("2006-01-02 15:04:05")
+}
+
+// String returns the LocalDateTime in ISO 8601 format yyyy-MM-ddTHH:mm:ss, followed by the fraction of a second when it is not zero.
+func (ldt LocalDateTime) String() string {
+	return ldt.ToFormatString(localDateTimeLayout)
+}
+
+// MarshalText implements encoding.TextMarshaler using the ISO 8601 format.
+func (ldt LocalDateTime) MarshalText() ([]byte, error) {
+	return []byte(ldt.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting the formats of ParseLocalDateTime.
+func (ldt *LocalDateTime) UnmarshalText(text []byte) error {
+	if ldt == nil {
+		return errors.New("UnmarshalText on nil pointer")
+	}
+	parsed, err := ParseLocalDateTime(string(text))
+	if err != nil {
+		return err
+	}
+	*ldt = parsed
+	return nil
+}
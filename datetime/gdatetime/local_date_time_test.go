@@ -0,0 +1,131 @@
+package gdatetime
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestLocalDateTimeOf(t *testing.T) {
+	ldt := must(LocalDateTimeOf(2024, 6, 3, 9, 30, 15, 7))
+	if ldt.GetYear() != 2024 || ldt.GetMonth() != 6 || ldt.GetDayOfMonth() != 3 ||
+		ldt.GetHour() != 9 || ldt.GetMinute() != 30 || ldt.GetSecond() != 15 || ldt.GetNano() != 7 {
+		t.Errorf("LocalDateTimeOf failed, got %v", ldt)
+	}
+	if ldt.ToLocalDate() != must(LocalDateOf(2024, 6, 3)) || ldt.ToLocalTime() != must(LocalTimeOf(9, 30, 15, 7)) {
+		t.Errorf("ToLocalDate/ToLocalTime failed for %v", ldt)
+	}
+	if _, err := LocalDateTimeOf(2024, 6, 31, 0, 0, 0, 0); err == nil {
+		t.Error("LocalDateTimeOf(2024-06-31) should fail")
+	}
+	if _, err := LocalDateTimeOf(2024, 6, 30, 0, 60, 0, 0); err == nil {
+		t.Error("LocalDateTimeOf with minute 60 should fail")
+	}
+}
+
+func TestLocalDateTimePlusCarriesIntoDate(t *testing.T) {
+	ldt := must(LocalDateTimeOf(2023, 12, 31, 23, 0, 0, 0))
+	cases := []struct {
+		name     string
+		got      LocalDateTime
+		expected LocalDateTime
+	}{
+		{"PlusHours", ldt.PlusHours(2), must(LocalDateTimeOf(2024, 1, 1, 1, 0, 0, 0))},
+		{"MinusHours", ldt.MinusHours(48), must(LocalDateTimeOf(2023, 12, 29, 23, 0, 0, 0))},
+		{"PlusMinutes", ldt.PlusMinutes(-23 * 60), must(LocalDateTimeOf(2023, 12, 31, 0, 0, 0, 0))},
+		{"MinusNanos", ldt.MinusNanos(23*3600*1000000000 + 1), must(LocalDateTimeOf(2023, 12, 30, 23, 59, 59, 999999999))},
+		{"PlusMonths", must(LocalDateTimeOf(2024, 1, 31, 8, 0, 0, 0)).PlusMonths(1), must(LocalDateTimeOf(2024, 2, 29, 8, 0, 0, 0))},
+		{"PlusDays", ldt.PlusDays(1), must(LocalDateTimeOf(2024, 1, 1, 23, 0, 0, 0))},
+	}
+	for _, c := range cases {
+		if c.got != c.expected {
+			t.Errorf("%s failed, expected %v, got %v", c.name, c.expected, c.got)
+		}
+	}
+}
+
+func TestLocalDateTimeWith(t *testing.T) {
+	ldt := must(LocalDateTimeOf(2024, 5, 31, 9, 0, 0, 0))
+	if got, _ := ldt.WithMonth(6); got != must(LocalDateTimeOf(2024, 6, 30, 9, 0, 0, 0)) {
+		t.Errorf("WithMonth failed, got %v", got)
+	}
+	if got, _ := ldt.WithHour(18); got != must(LocalDateTimeOf(2024, 5, 31, 18, 0, 0, 0)) {
+		t.Errorf("WithHour failed, got %v", got)
+	}
+	if _, err := ldt.WithSecond(-1); err == nil {
+		t.Error("WithSecond(-1) should fail")
+	}
+}
+
+func TestLocalDateTimeAtZone(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("America/New_York not available")
+	}
+	opensAt := must(LocalDateOf(2024, 3, 10)).AtTime(must(LocalTimeOf(9, 0, 0, 0)))
+	gdt, err := opensAt.AtZone(loc)
+	if err != nil {
+		t.Fatalf("AtZone returned error: %v", err)
+	}
+	if !gdt.t.Equal(time.Date(2024, 3, 10, 9, 0, 0, 0, loc)) {
+		t.Errorf("AtZone failed, got %v", gdt.t)
+	}
+	// the wall clock survives a zone conversion of the GDateTime and back
+	if got := gdt.InLocation(time.UTC).ToLocalDateTime(); got != must(LocalDateTimeOf(2024, 3, 10, 13, 0, 0, 0)) {
+		t.Errorf("ToLocalDateTime in UTC failed, got %v", got)
+	}
+	if _, err := opensAt.AtZone(nil); err != ErrNilLocation {
+		t.Errorf("AtZone(nil) should return ErrNilLocation, got %v", err)
+	}
+}
+
+func TestLocalDateTimeCompare(t *testing.T) {
+	a := must(LocalDateTimeOf(2024, 6, 3, 23, 59, 0, 0))
+	b := must(LocalDateTimeOf(2024, 6, 4, 0, 0, 0, 0))
+	if !a.IsBefore(b) || !b.IsAfter(a) || a.CompareTo(b) != -1 || !a.IsEqual(a) {
+		t.Errorf("LocalDateTime comparison failed")
+	}
+}
+
+func TestLocalDateTimeFormatAndParse(t *testing.T) {
+	ldt := must(LocalDateTimeOf(2024, 6, 3, 9, 5, 0, 0))
+	if ldt.String() != "2024-06-03T09:05:00" || ldt.ToDateTimeString() != "2024-06-03 09:05:00" {
+		t.Errorf("String failed, got %s", ldt.String())
+	}
+	if got := ldt.Strftime("%Y-%m-%d %H:%M%Z"); got != "2024-06-03 09:05" {
+		t.Errorf("Strftime failed, got %q", got)
+	}
+
+	for _, input := range []string{"2024-06-03T09:05:00", "2024-06-03 09:05:00", "2024-06-03T09:05"} {
+		got, err := ParseLocalDateTime(input)
+		if err != nil || got != ldt {
+			t.Errorf("ParseLocalDateTime(%s) failed, got %v, %v", input, got, err)
+		}
+	}
+	if _, err := ParseLocalDateTime("2024-06-03X09:05"); err == nil {
+		t.Error("ParseLocalDateTime should fail on a bad separator")
+	}
+
+	withNanos := ldt.PlusNanos(1500)
+	data, _ := json.Marshal(withNanos)
+	if string(data) != `"2024-06-03T09:05:00.0000015"` {
+		t.Errorf("json.Marshal failed, got %s", data)
+	}
+	var decoded LocalDateTime
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded != withNanos {
+		t.Errorf("json.Unmarshal failed, got %v, %v", decoded, err)
+	}
+}
+
+func TestLocalDateTimeTextRoundTripBeyondFourDigits(t *testing.T) {
+	for _, ldt := range []LocalDateTime{must(LocalDateTimeOf(12345, 1, 2, 9, 5, 30, 500)), must(LocalDateTimeOf(-5, 1, 2, 23, 59, 0, 0))} {
+		text, _ := ldt.MarshalText()
+		var got LocalDateTime
+		if err := got.UnmarshalText(text); err != nil || got != ldt {
+			t.Errorf("LocalDateTime %s does not round trip, got %v, %v", text, got, err)
+		}
+	}
+	if got, err := ParseLocalDateTime("-0005-01-02 09:05"); err != nil || got != must(LocalDateTimeOf(-5, 1, 2, 9, 5, 0, 0)) {
+		t.Errorf("ParseLocalDateTime(-0005-01-02 09:05) failed, got %v, %v", got, err)
+	}
+}
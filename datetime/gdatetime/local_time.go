@@ -0,0 +1,248 @@
+package gdatetime
+
+import (
+	"errors"
+	"time"
+
+	"github.com/linsongze/go-date-time/datetime/chronofield"
+	"github.com/linsongze/go-date-time/datetime/timeconst"
+)
+
+// LocalTime is a time of day without a date or a location, such as a store's opening hour.
+// It is a value type, comparable with ==, and its arithmetic wraps around midnight.
+type LocalTime struct {
+	hour   int
+	minute int
+	second int
+	nano   int
+}
+
+// localTimeLayout is the layout used by String, the fraction is omitted when zero.
+const localTimeLayout = "15:04:05.999999999"
+
+// LocalTimeOf Obtains a LocalTime from hour, minute, second and nanosecond, every field is validated strictly
+func LocalTimeOf(hour, minute, second, nanoOfSecond int) (LocalTime, error) {
+	if err := checkTimeFields(hour, minute, second, nanoOfSecond); err != nil {
+		return LocalTime{}, err
+	}
+	return LocalTime{hour: hour, minute: minute, second: second, nano: nanoOfSecond}, nil
+}
+
+// LocalTimeOfNanoOfDay Obtains a LocalTime from the nanoseconds since midnight
+func LocalTimeOfNanoOfDay(nanoOfDay int64) (LocalTime, error) {
+	if err := checkField(chronofield.NANO_OF_DAY, int(nanoOfDay), 0, int(timeconst.NANOS_PER_DAY-1)); err != nil {
+		return LocalTime{}, err
+	}
+	return localTimeOfNanoOfDay(nanoOfDay), nil
+}
+
+// ParseLocalTime parses a time of day such as "09:00", "09:00:30" or "09:00:30.5", a failure is returned as a *ParseError
+func ParseLocalTime(s string) (LocalTime, error) {
+	layout := "15:04:05"
+	if len(s) == len("15:04") {
+		layout = "15:04"
+	}
+	t, err := time.Parse(layout, s)
+	if err != nil {
+		return LocalTime{}, newParseError(s, layout, err)
+	}
+	return localTimeOf(t), nil
+}
+
+// localTimeOf returns the time of day of the wall clock of t.
+func localTimeOf(t time.Time) LocalTime {
+	return LocalTime{hour: t.Hour(), minute: t.Minute(), second: t.Second(), nano: t.Nanosecond()}
+}
+
+// localTimeOfNanoOfDay returns the LocalTime of nanoseconds since midnight, which must be within a day.
+func localTimeOfNanoOfDay(nanos int64) LocalTime {
+	return LocalTime{
+		hour:   int(nanos / timeconst.NANOS_PER_HOUR),
+		minute: int(nanos % timeconst.NANOS_PER_HOUR / timeconst.NANOS_PER_MINUTE),
+		second: int(nanos % timeconst.NANOS_PER_MINUTE / timeconst.NANOS_PER_SECOND),
+		nano:   int(nanos % timeconst.NANOS_PER_SECOND),
+	}
+}
+
+// ToLocalTime returns the time of day of the GDateTime on its own wall clock, dropping the date and the location.
+func (gdt *GDateTime) ToLocalTime() LocalTime {
+	return localTimeOf(gdt.t)
+}
+
+// toTime returns the time of day on 2000-01-01 UTC, used for formatting.
+func (lt LocalTime) toTime() time.Time {
+	return time.Date(2000, time.January, 1, lt.hour, lt.minute, lt.second, lt.nano, time.UTC)
+}
+
+// GetHour returns the hour of the LocalTime.
+func (lt LocalTime) GetHour() int {
+	return lt.hour
+}
+
+// GetMinute returns the minute of the LocalTime.
+func (lt LocalTime) GetMinute() int {
+	return lt.minute
+}
+
+// GetSecond returns the second of the LocalTime.
+func (lt LocalTime) GetSecond() int {
+	return lt.second
+}
+
+// GetNano returns the nanosecond of the LocalTime.
+func (lt LocalTime) GetNano() int {
+	return lt.nano
+}
+
+// NanoOfDay returns the LocalTime as nanoseconds since midnight.
+func (lt LocalTime) NanoOfDay() int64 {
+	return int64(lt.hour)*timeconst.NANOS_PER_HOUR + int64(lt.minute)*timeconst.NANOS_PER_MINUTE +
+		int64(lt.second)*timeconst.NANOS_PER_SECOND + int64(lt.nano)
+}
+
+// SecondOfDay returns the LocalTime as seconds since midnight.
+func (lt LocalTime) SecondOfDay() int {
+	return lt.hour*timeconst.SECONDS_PER_HOUR + lt.minute*timeconst.SECONDS_PER_MINUTE + lt.second
+}
+
+// WithHour Returns a copy of this LocalTime with the hour-of-day altered.
+func (lt LocalTime) WithHour(hour int) (LocalTime, error) {
+	return LocalTimeOf(hour, lt.minute, lt.second, lt.nano)
+}
+
+// WithMinute Returns a copy of this LocalTime with the minute altered.
+func (lt LocalTime) WithMinute(minute int) (LocalTime, error) {
+	return LocalTimeOf(lt.hour, minute, lt.second, lt.nano)
+}
+
+// WithSecond Returns a copy of this LocalTime with the second altered.
+func (lt LocalTime) WithSecond(second int) (LocalTime, error) {
+	return LocalTimeOf(lt.hour, lt.minute, second, lt.nano)
+}
+
+// WithNano Returns a copy of this LocalTime with the nanosecond altered.
+func (lt LocalTime) WithNano(nano int) (LocalTime, error) {
+	return LocalTimeOf(lt.hour, lt.minute, lt.second, nano)
+}
+
+// PlusHours adds hours to the LocalTime, wrapping around midnight, so 23:00 + 2 hours is 01:00.
+func (lt LocalTime) PlusHours(hours int) LocalTime {
+	lt, _ = lt.plusWithOverflow(hours, 0, 0, 0)
+	return lt
+}
+
+// PlusMinutes adds minutes to the LocalTime, wrapping around midnight.
+func (lt LocalTime) PlusMinutes(minutes int) LocalTime {
+	lt, _ = lt.plusWithOverflow(0, minutes, 0, 0)
+	return lt
+}
+
+// PlusSeconds adds seconds to the LocalTime, wrapping around midnight.
+func (lt LocalTime) PlusSeconds(seconds int) LocalTime {
+	lt, _ = lt.plusWithOverflow(0, 0, seconds, 0)
+	return lt
+}
+
+// PlusNanos adds nanoseconds to the LocalTime, wrapping around midnight.
+func (lt LocalTime) PlusNanos(nanos int) LocalTime {
+	lt, _ = lt.plusWithOverflow(0, 0, 0, nanos)
+	return lt
+}
+
+// MinusHours subtracts hours from the LocalTime, wrapping around midnight, so 01:00 - 2 hours is 23:00.
+func (lt LocalTime) MinusHours(hours int) LocalTime {
+	return lt.PlusHours(-hours)
+}
+
+// MinusMinutes subtracts minutes from the LocalTime, wrapping around midnight.
+func (lt LocalTime) MinusMinutes(minutes int) LocalTime {
+	return lt.PlusMinutes(-minutes)
+}
+
+// MinusSeconds subtracts seconds from the LocalTime, wrapping around midnight.
+func (lt LocalTime) MinusSeconds(seconds int) LocalTime {
+	return lt.PlusSeconds(-seconds)
+}
+
+// MinusNanos subtracts nanoseconds from the LocalTime, wrapping around midnight.
+func (lt LocalTime) MinusNanos(nanos int) LocalTime {
+	return lt.PlusNanos(-nanos)
+}
+
+// plusWithOverflow adds the amounts to the LocalTime and also returns the number of days crossed, negative when going back.
+// Each amount is split into whole days and a remainder first, so large amounts do not overflow.
+func (lt LocalTime) plusWithOverflow(hours, minutes, seconds, nanos int) (LocalTime, int) {
+	h, mi, s, n := int64(hours), int64(minutes), int64(seconds), int64(nanos)
+	days := h/timeconst.HOURS_PER_DAY + mi/timeconst.MINUTES_PER_DAY + s/timeconst.SECONDS_PER_DAY + n/timeconst.NANOS_PER_DAY
+	total := h%timeconst.HOURS_PER_DAY*timeconst.NANOS_PER_HOUR +
+		mi%timeconst.MINUTES_PER_DAY*timeconst.NANOS_PER_MINUTE +
+		s%timeconst.SECONDS_PER_DAY*timeconst.NANOS_PER_SECOND +
+		n%timeconst.NANOS_PER_DAY + lt.NanoOfDay()
+	days += int64(floorDiv(int(total), int(timeconst.NANOS_PER_DAY)))
+	return localTimeOfNanoOfDay(int64(floorMod(int(total), int(timeconst.NANOS_PER_DAY)))), int(days)
+}
+
+// CompareTo compares this LocalTime with another, returning -1, 0 or 1.
+func (lt LocalTime) CompareTo(other LocalTime) int {
+	a, b := lt.NanoOfDay(), other.NanoOfDay()
+	if a < b {
+		return -1
+	} else if a > b {
+		return 1
+	}
+	return 0
+}
+
+// IsBefore checks if this LocalTime is before the other.
+func (lt LocalTime) IsBefore(other LocalTime) bool {
+	return lt.CompareTo(other) < 0
+}
+
+// IsAfter checks if this LocalTime is after the other.
+func (lt LocalTime) IsAfter(other LocalTime) bool {
+	return lt.CompareTo(other) > 0
+}
+
+// IsEqual checks if this LocalTime is the same time of day as the other.
+func (lt LocalTime) IsEqual(other LocalTime) bool {
+	return lt == other
+}
+
+// AtDate combines the LocalTime with a LocalDate to create a LocalDateTime.
+func (lt LocalTime) AtDate(d LocalDate) LocalDateTime {
+	return LocalDateTime{date: d, time: lt}
+}
+
+// ToFormatString formats the LocalTime based on time package layout specifier, date elements give 2000-01-01.
+func (lt LocalTime) ToFormatString(layout string) string {
+	return lt.toTime().Format(layout)
+}
+
+// Strftime formats the LocalTime with a C style format, date directives give 2000-01-01 and %z, %Z are empty.
+func (lt LocalTime) Strftime(f string) string {
+	t := lt.toTime()
+	return strftime(&t, f, false)
+}
+
+// String returns the LocalTime as HH:mm:ss, followed by the fraction of a second when it is not zero.
+func (lt LocalTime) String() string {
+	return lt.ToFormatString(localTimeLayout)
+}
+
+// MarshalText implements encoding.TextMarshaler using the String format.
+func (lt LocalTime) MarshalText() ([]byte, error) {
+	return []byte(lt.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting the formats of ParseLocalTime.
+func (lt *LocalTime) UnmarshalText(text []byte) error {
+	if lt == nil {
+		return errors.New("UnmarshalText on nil pointer")
+	}
+	parsed, err := ParseLocalTime(string(text))
+	if err != nil {
+		return err
+	}
+	*lt = parsed
+	return nil
+}
@@ -0,0 +1,121 @@
+package gdatetime
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/linsongze/go-date-time/datetime/chronofield"
+)
+
+func TestLocalTimeOf(t *testing.T) {
+	lt := must(LocalTimeOf(9, 30, 15, 500))
+	if lt.GetHour() != 9 || lt.GetMinute() != 30 || lt.GetSecond() != 15 || lt.GetNano() != 500 {
+		t.Errorf("LocalTimeOf failed, got %v", lt)
+	}
+	if lt.SecondOfDay() != 9*3600+30*60+15 {
+		t.Errorf("SecondOfDay failed, got %d", lt.SecondOfDay())
+	}
+
+	_, err := LocalTimeOf(24, 0, 0, 0)
+	var rangeErr *FieldRangeError
+	if !errors.As(err, &rangeErr) || rangeErr.Field != chronofield.HOUR_OF_DAY {
+		t.Errorf("LocalTimeOf(24:00) should fail on HourOfDay, got %v", err)
+	}
+
+	fromNanos, err := LocalTimeOfNanoOfDay(lt.NanoOfDay())
+	if err != nil || fromNanos != lt {
+		t.Errorf("LocalTimeOfNanoOfDay failed, got %v, %v", fromNanos, err)
+	}
+	if _, err := LocalTimeOfNanoOfDay(24 * int64(time.Hour)); err == nil {
+		t.Error("LocalTimeOfNanoOfDay should fail for a full day")
+	}
+}
+
+func TestLocalTimeWrapsAroundMidnight(t *testing.T) {
+	cases := []struct {
+		name     string
+		got      LocalTime
+		expected LocalTime
+	}{
+		{"PlusHours", must(LocalTimeOf(23, 0, 0, 0)).PlusHours(2), must(LocalTimeOf(1, 0, 0, 0))},
+		{"MinusHours", must(LocalTimeOf(1, 0, 0, 0)).MinusHours(2), must(LocalTimeOf(23, 0, 0, 0))},
+		{"PlusHours many days", must(LocalTimeOf(10, 0, 0, 0)).PlusHours(24*365 + 3), must(LocalTimeOf(13, 0, 0, 0))},
+		{"PlusMinutes", must(LocalTimeOf(23, 50, 0, 0)).PlusMinutes(20), must(LocalTimeOf(0, 10, 0, 0))},
+		{"MinusSeconds", must(LocalTimeOf(0, 0, 0, 0)).MinusSeconds(1), must(LocalTimeOf(23, 59, 59, 0))},
+		{"PlusNanos", must(LocalTimeOf(23, 59, 59, 999999999)).PlusNanos(1), must(LocalTimeOf(0, 0, 0, 0))},
+		{"MinusNanos", must(LocalTimeOf(0, 0, 0, 0)).MinusNanos(1), must(LocalTimeOf(23, 59, 59, 999999999))},
+	}
+	for _, c := range cases {
+		if c.got != c.expected {
+			t.Errorf("%s failed, expected %v, got %v", c.name, c.expected, c.got)
+		}
+	}
+}
+
+func TestLocalTimeWith(t *testing.T) {
+	lt := must(LocalTimeOf(9, 30, 15, 0))
+	if got, _ := lt.WithHour(18); got != must(LocalTimeOf(18, 30, 15, 0)) {
+		t.Errorf("WithHour failed, got %v", got)
+	}
+	if got, _ := lt.WithNano(1); got != must(LocalTimeOf(9, 30, 15, 1)) {
+		t.Errorf("WithNano failed, got %v", got)
+	}
+	if _, err := lt.WithMinute(60); err == nil {
+		t.Error("WithMinute(60) should fail")
+	}
+}
+
+func TestLocalTimeCompare(t *testing.T) {
+	a := must(LocalTimeOf(9, 0, 0, 0))
+	b := must(LocalTimeOf(9, 0, 0, 1))
+	if !a.IsBefore(b) || !b.IsAfter(a) || b.CompareTo(a) != 1 || !a.IsEqual(a) {
+		t.Errorf("LocalTime comparison failed")
+	}
+}
+
+func TestLocalTimeFormatAndParse(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected LocalTime
+		str      string
+	}{
+		{"09:00", must(LocalTimeOf(9, 0, 0, 0)), "09:00:00"},
+		{"09:00:30", must(LocalTimeOf(9, 0, 30, 0)), "09:00:30"},
+		{"09:00:30.5", must(LocalTimeOf(9, 0, 30, 500000000)), "09:00:30.5"},
+	}
+	for _, c := range cases {
+		got, err := ParseLocalTime(c.input)
+		if err != nil || got != c.expected {
+			t.Errorf("ParseLocalTime(%s) failed, got %v, %v", c.input, got, err)
+		}
+		if got.String() != c.str {
+			t.Errorf("String failed, expected %s, got %s", c.str, got.String())
+		}
+	}
+	if _, err := ParseLocalTime("25:00"); !errors.Is(err, ErrParse) {
+		t.Errorf("ParseLocalTime(25:00) should fail with ErrParse, got %v", err)
+	}
+
+	lt := must(LocalTimeOf(14, 5, 0, 0))
+	if got := lt.Strftime("%I:%M %p%z"); got != "02:05 PM" {
+		t.Errorf("Strftime failed, got %q", got)
+	}
+	if got := lt.ToFormatString("3:04PM"); got != "2:05PM" {
+		t.Errorf("ToFormatString failed, got %s", got)
+	}
+
+	data, _ := json.Marshal(lt)
+	var decoded LocalTime
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded != lt {
+		t.Errorf("JSON round trip failed, got %v, %v", decoded, err)
+	}
+}
+
+func TestToLocalTime(t *testing.T) {
+	gdt := Create(time.Date(2024, 6, 3, 9, 0, 0, 0, time.FixedZone("", 8*3600)))
+	if got := gdt.ToLocalTime(); got != must(LocalTimeOf(9, 0, 0, 0)) {
+		t.Errorf("ToLocalTime failed, got %v", got)
+	}
+}
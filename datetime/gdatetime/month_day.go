@@ -0,0 +1,176 @@
+package gdatetime
+
+import (
+	"errors"
+	"iter"
+	"time"
+
+	"github.com/linsongze/go-date-time/datetime/chronofield"
+)
+
+// MonthDay is a day of a month without a year, such as an anniversary "--06-03".
+// Feb 29 is a valid MonthDay, AtYear resolves it to Feb 28 in a non-leap year.
+// It is a value type, comparable with ==.
+type MonthDay struct {
+	month int
+	day   int
+}
+
+// monthDayLayout is the ISO 8601 layout used by String and ParseMonthDay.
+const monthDayLayout = "--01-02"
+
+// monthDayLeapYear is the leap year used to validate and format a MonthDay, so Feb 29 is accepted.
+const monthDayLeapYear = 2000
+
+// MonthDayOf Obtains a MonthDay from month and day, the day is checked against the longest length of the month
+func MonthDayOf(month, dayOfMonth int) (MonthDay, error) {
+	if err := checkDateFields(monthDayLeapYear, month, dayOfMonth); err != nil {
+		return MonthDay{}, err
+	}
+	return MonthDay{month: month, day: dayOfMonth}, nil
+}
+
+// ParseMonthDay parses an ISO 8601 month-day such as "--06-03", a failure is returned as a *ParseError
+func ParseMonthDay(s string) (MonthDay, error) {
+	t, err := time.Parse(monthDayLayout, s)
+	if err != nil {
+		return MonthDay{}, newParseError(s, monthDayLayout, err)
+	}
+	return MonthDay{month: int(t.Month()), day: t.Day()}, nil
+}
+
+// ToMonthDay returns the month and day of the GDateTime on its own wall clock.
+func (gdt *GDateTime) ToMonthDay() MonthDay {
+	return MonthDay{month: int(gdt.t.Month()), day: gdt.t.Day()}
+}
+
+// toTime returns the MonthDay in a leap year at midnight UTC, used for formatting.
+func (md MonthDay) toTime() time.Time {
+	return time.Date(monthDayLeapYear, time.Month(md.month), md.day, 0, 0, 0, 0, time.UTC)
+}
+
+// GetMonth returns the month of the MonthDay as an integer (1-12).
+func (md MonthDay) GetMonth() int {
+	return md.month
+}
+
+// GetDayOfMonth returns the day of the month of the MonthDay.
+func (md MonthDay) GetDayOfMonth() int {
+	return md.day
+}
+
+// IsValidYear checks if the MonthDay exists in the year, which is false only for Feb 29 in a non-leap year.
+func (md MonthDay) IsValidYear(year int) bool {
+	return md.day <= DaysInMonth(year, md.month)
+}
+
+// AtYear returns the LocalDate of the MonthDay in the year, Feb 29 gives Feb 28 in a non-leap year.
+func (md MonthDay) AtYear(year int) LocalDate {
+	day := md.day
+	if maxDay := DaysInMonth(year, md.month); day > maxDay {
+		day = maxDay
+	}
+	return LocalDate{year: year, month: md.month, day: day}
+}
+
+// WithMonth Returns a copy of this MonthDay with the month altered, the day is clamped to the end of the new month.
+func (md MonthDay) WithMonth(month int) (MonthDay, error) {
+	if err := checkField(chronofield.MONTH_OF_YEAR, month, 1, 12); err != nil {
+		return MonthDay{}, err
+	}
+	return MonthDay{month: month, day: min(md.day, DaysInMonth(monthDayLeapYear, month))}, nil
+}
+
+// WithDayOfMonth Returns a copy of this MonthDay with the day-of-month altered.
+func (md MonthDay) WithDayOfMonth(day int) (MonthDay, error) {
+	return MonthDayOf(md.month, day)
+}
+
+// PlusMonths adds months to the MonthDay, wrapping around the year, the day is clamped to the end of the new month.
+func (md MonthDay) PlusMonths(months int) MonthDay {
+	month := floorMod(md.month-1+months, 12) + 1
+	return MonthDay{month: month, day: min(md.day, DaysInMonth(monthDayLeapYear, month))}
+}
+
+// MinusMonths subtracts months from the MonthDay.
+func (md MonthDay) MinusMonths(months int) MonthDay {
+	return md.PlusMonths(-months)
+}
+
+// NextOccurrence returns the first date of the MonthDay on or after the LocalDate,
+// Feb 29 occurs on Feb 28 in a non-leap year.
+func (md MonthDay) NextOccurrence(from LocalDate) LocalDate {
+	date := md.AtYear(from.year)
+	if date.IsBefore(from) {
+		date = md.AtYear(from.year + 1)
+	}
+	return date
+}
+
+// Occurrences iterates over the dates of the MonthDay, one per year, from the year up to, but not including, the end year.
+func (md MonthDay) Occurrences(fromYear, endYear int) iter.Seq[LocalDate] {
+	return func(yield func(LocalDate) bool) {
+		for year := fromYear; year < endYear; year++ {
+			if !yield(md.AtYear(year)) {
+				return
+			}
+		}
+	}
+}
+
+// CompareTo compares this MonthDay with another, month first then day, returning -1, 0 or 1.
+func (md MonthDay) CompareTo(other MonthDay) int {
+	if c := compareInt(md.month, other.month); c != 0 {
+		return c
+	}
+	return compareInt(md.day, other.day)
+}
+
+// IsBefore checks if this MonthDay is before the other in a year.
+func (md MonthDay) IsBefore(other MonthDay) bool {
+	return md.CompareTo(other) < 0
+}
+
+// IsAfter checks if this MonthDay is after the other in a year.
+func (md MonthDay) IsAfter(other MonthDay) bool {
+	return md.CompareTo(other) > 0
+}
+
+// IsEqual checks if this MonthDay is the same day as the other.
+func (md MonthDay) IsEqual(other MonthDay) bool {
+	return md == other
+}
+
+// ToFormatString formats the MonthDay based on time package layout specifier, year elements give a leap year.
+func (md MonthDay) ToFormatString(layout string) string {
+	return md.toTime().Format(layout)
+}
+
+// Strftime formats the MonthDay with a C style format such as "%d %B", year and weekday elements are not meaningful.
+func (md MonthDay) Strftime(f string) string {
+	t := md.toTime()
+	return strftime(&t, f, false)
+}
+
+// String returns the MonthDay in ISO 8601 format --MM-dd.
+func (md MonthDay) String() string {
+	return md.ToFormatString(monthDayLayout)
+}
+
+// MarshalText implements encoding.TextMarshaler using the ISO 8601 format.
+func (md MonthDay) MarshalText() ([]byte, error) {
+	return []byte(md.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the ISO 8601 format.
+func (md *MonthDay) UnmarshalText(text []byte) error {
+	if md == nil {
+		return errors.New("UnmarshalText on nil pointer")
+	}
+	parsed, err := ParseMonthDay(string(text))
+	if err != nil {
+		return err
+	}
+	*md = parsed
+	return nil
+}
@@ -0,0 +1,80 @@
+package gdatetime
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestMonthDayOf(t *testing.T) {
+	md := must(MonthDayOf(2, 29))
+	if md.GetMonth() != 2 || md.GetDayOfMonth() != 29 {
+		t.Errorf("MonthDayOf failed, got %v", md)
+	}
+	if md.IsValidYear(2023) || !md.IsValidYear(2024) {
+		t.Errorf("IsValidYear failed for %v", md)
+	}
+	if _, err := MonthDayOf(4, 31); !errors.Is(err, ErrFieldRange) {
+		t.Errorf("MonthDayOf(4, 31) should fail with ErrFieldRange, got %v", err)
+	}
+}
+
+func TestMonthDayAtYear(t *testing.T) {
+	leapDay := must(MonthDayOf(2, 29))
+	if got := leapDay.AtYear(2023); got != must(LocalDateOf(2023, 2, 28)) {
+		t.Errorf("AtYear(2023) should clamp Feb 29, got %v", got)
+	}
+	if got := leapDay.AtYear(2024); got != must(LocalDateOf(2024, 2, 29)) {
+		t.Errorf("AtYear(2024) failed, got %v", got)
+	}
+
+	anniversary := must(MonthDayOf(6, 3))
+	if got := anniversary.NextOccurrence(must(LocalDateOf(2024, 6, 3))); got != must(LocalDateOf(2024, 6, 3)) {
+		t.Errorf("NextOccurrence on the day failed, got %v", got)
+	}
+	if got := anniversary.NextOccurrence(must(LocalDateOf(2024, 6, 4))); got != must(LocalDateOf(2025, 6, 3)) {
+		t.Errorf("NextOccurrence after the day failed, got %v", got)
+	}
+
+	occurrences := slices.Collect(leapDay.Occurrences(2023, 2026))
+	expected := []LocalDate{must(LocalDateOf(2023, 2, 28)), must(LocalDateOf(2024, 2, 29)), must(LocalDateOf(2025, 2, 28))}
+	if !slices.Equal(occurrences, expected) {
+		t.Errorf("Occurrences failed, got %v", occurrences)
+	}
+}
+
+func TestMonthDayPlusAndCompare(t *testing.T) {
+	md := must(MonthDayOf(1, 31))
+	if got := md.PlusMonths(1); got != must(MonthDayOf(2, 29)) {
+		t.Errorf("PlusMonths should clamp to Feb 29, got %v", got)
+	}
+	if got := md.MinusMonths(2); got != must(MonthDayOf(11, 30)) {
+		t.Errorf("MinusMonths should wrap the year, got %v", got)
+	}
+	if got, _ := md.WithMonth(4); got != must(MonthDayOf(4, 30)) {
+		t.Errorf("WithMonth failed, got %v", got)
+	}
+	if !md.IsBefore(must(MonthDayOf(2, 1))) || !md.IsAfter(must(MonthDayOf(1, 30))) || !md.IsEqual(md) {
+		t.Errorf("comparison failed for %v", md)
+	}
+}
+
+func TestMonthDayFormatAndParse(t *testing.T) {
+	md := must(MonthDayOf(6, 3))
+	if md.String() != "--06-03" || md.Strftime("%d %B") != "03 June" {
+		t.Errorf("formatting failed for %v", md)
+	}
+	if got, err := ParseMonthDay("--02-29"); err != nil || got != must(MonthDayOf(2, 29)) {
+		t.Errorf("ParseMonthDay(--02-29) failed, got %v, %v", got, err)
+	}
+	if _, err := ParseMonthDay("06-03"); !errors.Is(err, ErrParse) {
+		t.Errorf("ParseMonthDay(06-03) should fail with ErrParse, got %v", err)
+	}
+
+	data, _ := json.Marshal(md)
+	var decoded MonthDay
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded != md || string(data) != `"--06-03"` {
+		t.Errorf("JSON round trip failed, got %s, %v, %v", data, decoded, err)
+	}
+}
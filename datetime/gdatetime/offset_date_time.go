@@ -0,0 +1,177 @@
+package gdatetime
+
+import (
+	"errors"
+	"time"
+
+	"github.com/linsongze/go-date-time/datetime/chronofield"
+)
+
+// OffsetDateTime is a local date-time with a fixed offset from UTC, such as "2024-11-03T01:30:00-04:00".
+// Unlike a GDateTime in a region zone like America/New_York, the offset never changes,
+// so arithmetic is plain elapsed time and the value does not follow DST rules.
+// It is a value type, == compares the local date-time and the offset, IsEqual compares the instant.
+type OffsetDateTime struct {
+	dateTime LocalDateTime
+	offset   int
+}
+
+// OffsetDateTimeOf Obtains an OffsetDateTime from a LocalDateTime and an offset in seconds, within -18:00 to +18:00
+func OffsetDateTimeOf(ldt LocalDateTime, offsetSeconds int) (OffsetDateTime, error) {
+	if err := checkField(chronofield.OFFSET_SECONDS, offsetSeconds, -18*3600, 18*3600); err != nil {
+		return OffsetDateTime{}, err
+	}
+	return OffsetDateTime{dateTime: ldt, offset: offsetSeconds}, nil
+}
+
+// ParseOffsetDateTime parses an RFC 3339 date-time such as "2024-11-03T01:30:00-04:00", a failure is returned as a *ParseError
+func ParseOffsetDateTime(s string) (OffsetDateTime, error) {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return OffsetDateTime{}, newParseError(s, time.RFC3339Nano, err)
+	}
+	return offsetDateTimeOf(t), nil
+}
+
+// offsetDateTimeOf returns the wall clock and the current offset of t.
+func offsetDateTimeOf(t time.Time) OffsetDateTime {
+	_, offset := t.Zone()
+	return OffsetDateTime{dateTime: localDateTimeOf(t), offset: offset}
+}
+
+// ToOffsetDateTime returns the wall clock of the GDateTime with the offset in effect at that instant,
+// dropping the region zone and its DST rules.
+func (gdt *GDateTime) ToOffsetDateTime() OffsetDateTime {
+	return offsetDateTimeOf(gdt.t)
+}
+
+// ToTime returns the OffsetDateTime as a time.Time in a fixed zone.
+func (odt OffsetDateTime) ToTime() time.Time {
+	d, t := odt.dateTime.date, odt.dateTime.time
+	return time.Date(d.year, time.Month(d.month), d.day, t.hour, t.minute, t.second, t.nano, time.FixedZone("", odt.offset))
+}
+
+// ToGDateTime returns the OffsetDateTime as a GDateTime in a fixed zone.
+func (odt OffsetDateTime) ToGDateTime() *GDateTime {
+	return Create(odt.ToTime())
+}
+
+// AtZoneSameInstant returns the GDateTime of the same instant in the given location.
+func (odt OffsetDateTime) AtZoneSameInstant(loc *time.Location) (*GDateTime, error) {
+	if loc == nil {
+		return nil, ErrNilLocation
+	}
+	return Create(odt.ToTime().In(loc)), nil
+}
+
+// ToLocalDateTime returns the local date-time part of the OffsetDateTime.
+func (odt OffsetDateTime) ToLocalDateTime() LocalDateTime {
+	return odt.dateTime
+}
+
+// Offset returns the offset from UTC in seconds, such as -14400 for -04:00.
+func (odt OffsetDateTime) Offset() int {
+	return odt.offset
+}
+
+// GetSecondTimestamp returns the Unix time in seconds of the OffsetDateTime.
+func (odt OffsetDateTime) GetSecondTimestamp() int64 {
+	return localSeconds(odt.dateTime) - int64(odt.offset)
+}
+
+// WithOffsetSameInstant returns the same instant with another offset, changing the local date-time.
+func (odt OffsetDateTime) WithOffsetSameInstant(offsetSeconds int) (OffsetDateTime, error) {
+	if err := checkField(chronofield.OFFSET_SECONDS, offsetSeconds, -18*3600, 18*3600); err != nil {
+		return OffsetDateTime{}, err
+	}
+	return OffsetDateTime{dateTime: odt.dateTime.PlusSeconds(offsetSeconds - odt.offset), offset: offsetSeconds}, nil
+}
+
+// WithOffsetSameLocal returns the same local date-time with another offset, changing the instant.
+func (odt OffsetDateTime) WithOffsetSameLocal(offsetSeconds int) (OffsetDateTime, error) {
+	return OffsetDateTimeOf(odt.dateTime, offsetSeconds)
+}
+
+// PlusPeriod adds the Period to the OffsetDateTime, months first with the day clamped, then days, keeping the offset.
+func (odt OffsetDateTime) PlusPeriod(p Period) OffsetDateTime {
+	return OffsetDateTime{dateTime: odt.dateTime.PlusPeriod(p), offset: odt.offset}
+}
+
+// PlusDuration adds the Duration to the OffsetDateTime, keeping the offset. With a fixed offset every day has 24 hours.
+func (odt OffsetDateTime) PlusDuration(d Duration) OffsetDateTime {
+	ldt := odt.dateTime.PlusPeriod(d.Period).PlusSeconds(int(d.Seconds)).PlusNanos(d.Nanos)
+	return OffsetDateTime{dateTime: ldt, offset: odt.offset}
+}
+
+// MinusPeriod subtracts the Period from the OffsetDateTime.
+func (odt OffsetDateTime) MinusPeriod(p Period) OffsetDateTime {
+	return odt.PlusPeriod(p.Negated())
+}
+
+// MinusDuration subtracts the Duration from the OffsetDateTime.
+func (odt OffsetDateTime) MinusDuration(d Duration) OffsetDateTime {
+	return odt.PlusDuration(d.Negated())
+}
+
+// CompareTo compares the instants of this OffsetDateTime and another, then the local date-time, returning -1, 0 or 1.
+func (odt OffsetDateTime) CompareTo(other OffsetDateTime) int {
+	a, b := odt.GetSecondTimestamp(), other.GetSecondTimestamp()
+	if a < b {
+		return -1
+	} else if a > b {
+		return 1
+	}
+	if c := compareInt(odt.dateTime.time.nano, other.dateTime.time.nano); c != 0 {
+		return c
+	}
+	return odt.dateTime.CompareTo(other.dateTime)
+}
+
+// IsBefore checks if the instant of this OffsetDateTime is before the other.
+func (odt OffsetDateTime) IsBefore(other OffsetDateTime) bool {
+	return odt.ToTime().Before(other.ToTime())
+}
+
+// IsAfter checks if the instant of this OffsetDateTime is after the other.
+func (odt OffsetDateTime) IsAfter(other OffsetDateTime) bool {
+	return odt.ToTime().After(other.ToTime())
+}
+
+// IsEqual checks if this OffsetDateTime is the same instant as the other, whatever the offsets.
+func (odt OffsetDateTime) IsEqual(other OffsetDateTime) bool {
+	return odt.ToTime().Equal(other.ToTime())
+}
+
+// ToFormatString formats the OffsetDateTime based on time package layout specifier.
+func (odt OffsetDateTime) ToFormatString(layout string) string {
+	return odt.ToTime().Format(layout)
+}
+
+// Strftime formats the OffsetDateTime with a C style format, %z gives the offset.
+func (odt OffsetDateTime) Strftime(f string) string {
+	t := odt.ToTime()
+	return strftime(&t, f, true)
+}
+
+// String returns the OffsetDateTime in RFC 3339 format, such as "2024-11-03T01:30:00-04:00".
+func (odt OffsetDateTime) String() string {
+	return odt.ToFormatString(time.RFC3339Nano)
+}
+
+// MarshalText implements encoding.TextMarshaler using the RFC 3339 format.
+func (odt OffsetDateTime) MarshalText() ([]byte, error) {
+	return []byte(odt.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the RFC 3339 format.
+func (odt *OffsetDateTime) UnmarshalText(text []byte) error {
+	if odt == nil {
+		return errors.New("UnmarshalText on nil pointer")
+	}
+	parsed, err := ParseOffsetDateTime(string(text))
+	if err != nil {
+		return err
+	}
+	*odt = parsed
+	return nil
+}
@@ -0,0 +1,93 @@
+package gdatetime
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestOffsetDateTimeOf(t *testing.T) {
+	ldt := must(LocalDateTimeOf(2024, 11, 3, 1, 30, 0, 0))
+	odt, err := OffsetDateTimeOf(ldt, -4*3600)
+	if err != nil {
+		t.Fatalf("OffsetDateTimeOf returned error: %v", err)
+	}
+	if odt.ToLocalDateTime() != ldt || odt.Offset() != -4*3600 {
+		t.Errorf("OffsetDateTimeOf failed, got %v", odt)
+	}
+	if !odt.ToTime().Equal(time.Date(2024, 11, 3, 5, 30, 0, 0, time.UTC)) || odt.GetSecondTimestamp() != odt.ToTime().Unix() {
+		t.Errorf("ToTime failed, got %v", odt.ToTime())
+	}
+	if _, err := OffsetDateTimeOf(ldt, 19*3600); !errors.Is(err, ErrFieldRange) {
+		t.Errorf("OffsetDateTimeOf with +19:00 should fail with ErrFieldRange, got %v", err)
+	}
+}
+
+func TestOffsetDateTimeKeepsOffsetAcrossDST(t *testing.T) {
+	loc := loadNewYork(t)
+	gdt, _ := ZonedOf(2024, 11, 2, 12, 0, 0, 0, loc, DSTError)
+	odt := gdt.ToOffsetDateTime()
+	if odt.Offset() != -4*3600 {
+		t.Fatalf("ToOffsetDateTime failed, got %v", odt)
+	}
+
+	// a day later the region zone has moved to EST, the fixed offset has not
+	nextDay := odt.PlusPeriod(PeriodOf(0, 0, 1))
+	if nextDay.Offset() != -4*3600 || nextDay.ToLocalDateTime() != must(LocalDateTimeOf(2024, 11, 3, 12, 0, 0, 0)) {
+		t.Errorf("PlusPeriod failed, got %v", nextDay)
+	}
+	zoned, _ := nextDay.AtZoneSameInstant(loc)
+	if zoned.GetHour() != 11 {
+		t.Errorf("AtZoneSameInstant should give 11:00 EST, got %v", zoned.t)
+	}
+	if got := gdt.PlusDays(1).ToOffsetDateTime(); got.Offset() != -5*3600 || got.ToLocalDateTime().GetHour() != 12 {
+		t.Errorf("a region zone GDateTime should follow DST, got %v", got)
+	}
+	if got := odt.PlusDuration(DurationOf(Period{}, 90*time.Minute)); got.ToLocalDateTime() != must(LocalDateTimeOf(2024, 11, 2, 13, 30, 0, 0)) {
+		t.Errorf("PlusDuration failed, got %v", got)
+	}
+	if got := odt.MinusDuration(DurationOf(PeriodOf(0, 0, 1), 0)); got.ToLocalDateTime() != must(LocalDateTimeOf(2024, 11, 1, 12, 0, 0, 0)) {
+		t.Errorf("MinusDuration failed, got %v", got)
+	}
+}
+
+func TestOffsetDateTimeWithOffset(t *testing.T) {
+	odt, _ := ParseOffsetDateTime("2024-11-03T01:30:00-04:00")
+	utc, err := odt.WithOffsetSameInstant(0)
+	if err != nil || utc.ToLocalDateTime() != must(LocalDateTimeOf(2024, 11, 3, 5, 30, 0, 0)) {
+		t.Errorf("WithOffsetSameInstant failed, got %v, %v", utc, err)
+	}
+	if !utc.IsEqual(odt) || utc == odt || utc.CompareTo(odt) == 0 {
+		t.Errorf("IsEqual should compare instants while == compares the fields")
+	}
+	sameLocal, _ := odt.WithOffsetSameLocal(-5 * 3600)
+	if sameLocal.ToLocalDateTime() != odt.ToLocalDateTime() || !sameLocal.IsAfter(odt) || !odt.IsBefore(sameLocal) {
+		t.Errorf("WithOffsetSameLocal failed, got %v", sameLocal)
+	}
+}
+
+func TestOffsetDateTimeFormatAndParse(t *testing.T) {
+	odt, err := ParseOffsetDateTime("2024-11-03T01:30:00.25-04:00")
+	if err != nil {
+		t.Fatalf("ParseOffsetDateTime returned error: %v", err)
+	}
+	if odt.String() != "2024-11-03T01:30:00.25-04:00" {
+		t.Errorf("String failed, got %s", odt.String())
+	}
+	if got := odt.Strftime("%Y-%m-%d %H:%M %z"); got != "2024-11-03 01:30 -0400" {
+		t.Errorf("Strftime failed, got %q", got)
+	}
+	if _, err := ParseOffsetDateTime("2024-11-03T01:30:00"); !errors.Is(err, ErrParse) {
+		t.Errorf("ParseOffsetDateTime without an offset should fail with ErrParse, got %v", err)
+	}
+
+	data, _ := json.Marshal(odt)
+	var decoded OffsetDateTime
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded != odt {
+		t.Errorf("JSON round trip failed, got %v, %v", decoded, err)
+	}
+	if odt.ToGDateTime().ToISO8601() != "2024-11-03T01:30:00-04:00" {
+		t.Errorf("ToGDateTime failed, got %s", odt.ToGDateTime().ToISO8601())
+	}
+}
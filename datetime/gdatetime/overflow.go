@@ -0,0 +1,111 @@
+package gdatetime
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/linsongze/go-date-time/datetime/chronofield"
+)
+
+// OverflowPolicy decides what happens to the day-of-month when a month or year change lands on a day that does not exist,
+// such as Jan 31 + 1 month.
+type OverflowPolicy int
+
+const (
+	// OverflowClamp clamps the day to the last day of the resulting month: Jan 31 + 1 month = Feb 28/29.
+	OverflowClamp OverflowPolicy = iota
+	// OverflowNormalize rolls the extra days into the next month like time.AddDate: Jan 31 + 1 month = Mar 2/3.
+	OverflowNormalize
+	// OverflowEndOfMonthSticky keeps a date that is on the last day of its month on the last day of the resulting month:
+	// Feb 28 + 1 month = Mar 31. Other dates are clamped.
+	OverflowEndOfMonthSticky
+	// OverflowError returns an error instead of adjusting the day.
+	OverflowError
+)
+
+// String returns the name of the OverflowPolicy.
+func (p OverflowPolicy) String() string {
+	switch p {
+	case OverflowClamp:
+		return "Clamp"
+	case OverflowNormalize:
+		return "Normalize"
+	case OverflowEndOfMonthSticky:
+		return "EndOfMonthSticky"
+	case OverflowError:
+		return "Error"
+	default:
+		return fmt.Sprintf("OverflowPolicy(%d)", int(p))
+	}
+}
+
+// resolveDayOfMonth returns the day to use in newYear/newMonth for a date that was year/month/day before the change.
+// With OverflowNormalize the returned day may exceed the length of the month and is left to time.Date to normalize.
+func resolveDayOfMonth(year, month, day, newYear, newMonth int, policy OverflowPolicy) (int, error) {
+	maxDay := DaysInMonth(newYear, newMonth)
+	switch policy {
+	case OverflowNormalize:
+		return day, nil
+	case OverflowEndOfMonthSticky:
+		if day == DaysInMonth(year, month) {
+			return maxDay, nil
+		}
+	case OverflowError:
+		if err := checkField(chronofield.DAY_OF_MONTH, day, 1, maxDay); err != nil {
+			return 0, err
+		}
+	case OverflowClamp:
+	default:
+		return 0, fmt.Errorf("unknown overflow policy %v", policy)
+	}
+	if day > maxDay {
+		return maxDay, nil
+	}
+	return day, nil
+}
+
+// withDate returns a copy of the GDateTime moved to newYear/newMonth, resolving the day-of-month with the policy.
+func (gdt *GDateTime) withDate(newYear, newMonth int, policy OverflowPolicy) (*GDateTime, error) {
+	day, err := resolveDayOfMonth(gdt.t.Year(), int(gdt.t.Month()), gdt.t.Day(), newYear, newMonth, policy)
+	if err != nil {
+		return nil, err
+	}
+	newTime := time.Date(newYear, time.Month(newMonth), day, gdt.t.Hour(), gdt.t.Minute(), gdt.t.Second(), gdt.t.Nanosecond(), gdt.t.Location())
+	return Create(newTime), nil
+}
+
+// PlusMonthsWith adds the specified number of months to the GDateTime, resolving a day that does not exist with the policy.
+func (gdt *GDateTime) PlusMonthsWith(months int, policy OverflowPolicy) (*GDateTime, error) {
+	total := gdt.t.Year()*12 + int(gdt.t.Month()) - 1 + months
+	year, month := floorDiv(total, 12), floorMod(total, 12)+1
+	return gdt.withDate(year, month, policy)
+}
+
+// PlusYearsWith adds the specified number of years to the GDateTime, resolving Feb 29 in a non-leap year with the policy.
+func (gdt *GDateTime) PlusYearsWith(years int, policy OverflowPolicy) (*GDateTime, error) {
+	return gdt.withDate(gdt.t.Year()+years, int(gdt.t.Month()), policy)
+}
+
+// MinusMonthsWith subtracts the specified number of months from the GDateTime, resolving a day that does not exist with the policy.
+func (gdt *GDateTime) MinusMonthsWith(months int, policy OverflowPolicy) (*GDateTime, error) {
+	return gdt.PlusMonthsWith(-months, policy)
+}
+
+// MinusYearsWith subtracts the specified number of years from the GDateTime, resolving Feb 29 in a non-leap year with the policy.
+func (gdt *GDateTime) MinusYearsWith(years int, policy OverflowPolicy) (*GDateTime, error) {
+	return gdt.PlusYearsWith(-years, policy)
+}
+
+// floorDiv returns the integer division of x by y rounded towards negative infinity.
+func floorDiv(x, y int) int {
+	q := x / y
+	if (x%y != 0) && ((x < 0) != (y < 0)) {
+		q--
+	}
+	return q
+}
+
+// floorMod returns the modulus of x by y with the sign of y.
+func floorMod(x, y int) int {
+	return x - floorDiv(x, y)*y
+}
@@ -0,0 +1,97 @@
+package gdatetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPlusMonthsWith(t *testing.T) {
+	cases := []struct {
+		name     string
+		start    time.Time
+		months   int
+		policy   OverflowPolicy
+		expected time.Time
+		wantErr  bool
+	}{
+		{"Clamp", time.Date(2024, 1, 31, 8, 0, 0, 0, time.UTC), 1, OverflowClamp, time.Date(2024, 2, 29, 8, 0, 0, 0, time.UTC), false},
+		{"Clamp Non Leap", time.Date(2023, 1, 31, 8, 0, 0, 0, time.UTC), 1, OverflowClamp, time.Date(2023, 2, 28, 8, 0, 0, 0, time.UTC), false},
+		{"Normalize", time.Date(2023, 1, 31, 8, 0, 0, 0, time.UTC), 1, OverflowNormalize, time.Date(2023, 3, 3, 8, 0, 0, 0, time.UTC), false},
+		{"Sticky End Of Month", time.Date(2023, 2, 28, 8, 0, 0, 0, time.UTC), 1, OverflowEndOfMonthSticky, time.Date(2023, 3, 31, 8, 0, 0, 0, time.UTC), false},
+		{"Sticky Not End Of Month", time.Date(2024, 2, 28, 8, 0, 0, 0, time.UTC), 1, OverflowEndOfMonthSticky, time.Date(2024, 3, 28, 8, 0, 0, 0, time.UTC), false},
+		{"Sticky Clamps", time.Date(2024, 3, 30, 8, 0, 0, 0, time.UTC), -1, OverflowEndOfMonthSticky, time.Date(2024, 2, 29, 8, 0, 0, 0, time.UTC), false},
+		{"Error", time.Date(2024, 1, 31, 8, 0, 0, 0, time.UTC), 1, OverflowError, time.Time{}, true},
+		{"Error Valid Day", time.Date(2024, 1, 15, 8, 0, 0, 0, time.UTC), 1, OverflowError, time.Date(2024, 2, 15, 8, 0, 0, 0, time.UTC), false},
+		{"Negative Across Year", time.Date(2024, 3, 31, 8, 0, 0, 0, time.UTC), -13, OverflowClamp, time.Date(2023, 2, 28, 8, 0, 0, 0, time.UTC), false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Create(tc.start).PlusMonthsWith(tc.months, tc.policy)
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("PlusMonthsWith should fail, got %v", got.t)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("PlusMonthsWith returned error: %v", err)
+			}
+			if got.t != tc.expected {
+				t.Errorf("PlusMonthsWith failed, expected %v, got %v", tc.expected, got.t)
+			}
+		})
+	}
+}
+
+func TestPlusYearsWith(t *testing.T) {
+	leapDay := Create(time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC))
+
+	if got, _ := leapDay.PlusYearsWith(1, OverflowClamp); got.t != time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC) {
+		t.Errorf("PlusYearsWith(Clamp) failed, got %v", got.t)
+	}
+	if got, _ := leapDay.PlusYearsWith(1, OverflowNormalize); got.t != time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC) {
+		t.Errorf("PlusYearsWith(Normalize) failed, got %v", got.t)
+	}
+	if got, _ := leapDay.MinusYearsWith(4, OverflowError); got.t != time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC) {
+		t.Errorf("MinusYearsWith(Error) failed, got %v", got.t)
+	}
+	if _, err := leapDay.PlusYearsWith(1, OverflowError); err == nil {
+		t.Error("PlusYearsWith(Error) should fail for Feb 29 in a non-leap year")
+	}
+	if got, _ := Create(time.Date(2023, 2, 28, 0, 0, 0, 0, time.UTC)).PlusYearsWith(1, OverflowEndOfMonthSticky); got.t != time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC) {
+		t.Errorf("PlusYearsWith(EndOfMonthSticky) failed, got %v", got.t)
+	}
+}
+
+func TestWithMonthAndYearClamp(t *testing.T) {
+	gdt := Create(time.Date(2024, 5, 31, 10, 0, 0, 0, time.UTC))
+	if got, _ := gdt.WithMonth(2); got.t != time.Date(2024, 2, 29, 10, 0, 0, 0, time.UTC) {
+		t.Errorf("WithMonth failed, expected clamp to Feb 29, got %v", got.t)
+	}
+	if _, err := gdt.WithMonthPolicy(6, OverflowError); err == nil {
+		t.Error("WithMonthPolicy(Error) should fail for Jun 31")
+	}
+
+	leapDay := Create(time.Date(2024, 2, 29, 10, 0, 0, 0, time.UTC))
+	if got, _ := leapDay.WithYear(2023); got.t != time.Date(2023, 2, 28, 10, 0, 0, 0, time.UTC) {
+		t.Errorf("WithYear failed, expected clamp to Feb 28, got %v", got.t)
+	}
+	if got, _ := leapDay.WithYearPolicy(2023, OverflowNormalize); got.t != time.Date(2023, 3, 1, 10, 0, 0, 0, time.UTC) {
+		t.Errorf("WithYearPolicy(Normalize) failed, got %v", got.t)
+	}
+}
+
+func TestFloorDivMod(t *testing.T) {
+	cases := []struct{ x, y, div, mod int }{
+		{7, 12, 0, 7},
+		{-1, 12, -1, 11},
+		{-12, 12, -1, 0},
+		{-13, 12, -2, 11},
+	}
+	for _, c := range cases {
+		if d, m := floorDiv(c.x, c.y), floorMod(c.x, c.y); d != c.div || m != c.mod {
+			t.Errorf("floorDiv/floorMod(%d, %d) failed, expected %d, %d, got %d, %d", c.x, c.y, c.div, c.mod, d, m)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package gdatetime
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Period is a date-based amount of time in years, months and days, such as "1 year 2 months 3 days".
+type Period struct {
+	Years  int
+	Months int
+	Days   int
+}
+
+// periodLayout describes the accepted period notation in a ParseError.
+const periodLayout = "PnYnMnWnD"
+
+var periodPattern = regexp.MustCompile(`^([-+]?)P(?:([-+]?[0-9]+)Y)?(?:([-+]?[0-9]+)M)?(?:([-+]?[0-9]+)W)?(?:([-+]?[0-9]+)D)?$`)
+
+// PeriodOf Obtains a Period from years, months and days
+func PeriodOf(years, months, days int) Period {
+	return Period{Years: years, Months: months, Days: days}
+}
+
+// PeriodBetween calculates the period between the dates of a and b.
+// The time of day is ignored and b is viewed in the location of a.
+// The result is negative if b is before a.
+func PeriodBetween(a, b *GDateTime) Period {
+	end := b.t.In(a.t.Location())
+	startYear, startMonth, startDay := a.t.Date()
+	endYear, endMonth, endDay := end.Date()
+
+	totalMonths := (endYear*12 + int(endMonth)) - (startYear*12 + int(startMonth))
+	days := endDay - startDay
+	if totalMonths > 0 && days < 0 {
+		totalMonths--
+		y, m, d := plusMonthsClamped(startYear, int(startMonth), startDay, totalMonths)
+		days = int(epochDay(endYear, int(endMonth), endDay) - epochDay(y, m, d))
+	} else if totalMonths < 0 && days > 0 {
+		totalMonths++
+		days -= DaysInMonth(endYear, int(endMonth))
+	}
+	return Period{Years: totalMonths / 12, Months: totalMonths % 12, Days: days}
+}
+
+// ParsePeriod parses an ISO 8601 period such as "P1Y2M3D", "P2W" or "-P1M".
+// Weeks are converted to days.
+func ParsePeriod(s string) (Period, error) {
+	match := periodPattern.FindStringSubmatch(strings.ToUpper(s))
+	if match == nil || (match[2] == "" && match[3] == "" && match[4] == "" && match[5] == "") {
+		return Period{}, &ParseError{Input: s, Layout: periodLayout, Offset: -1, Reason: "invalid ISO 8601 period"}
+	}
+	values := make([]int, 4)
+	for i, part := range match[2:] {
+		if part == "" {
+			continue
+		}
+		v, err := strconv.Atoi(part)
+		if err != nil {
+			return Period{}, &ParseError{Input: s, Layout: periodLayout, Offset: -1, Reason: "invalid ISO 8601 period", Err: err}
+		}
+		values[i] = v
+	}
+	p := Period{Years: values[0], Months: values[1], Days: values[2]*7 + values[3]}
+	if match[1] == "-" {
+		p = p.Negated()
+	}
+	return p, nil
+}
+
+// IsZero checks if all three units of the Period are zero.
+func (p Period) IsZero() bool {
+	return p.Years == 0 && p.Months == 0 && p.Days == 0
+}
+
+// IsNegative checks if any of the three units of the Period is negative.
+func (p Period) IsNegative() bool {
+	return p.Years < 0 || p.Months < 0 || p.Days < 0
+}
+
+// TotalMonths returns the total number of months in the Period, ignoring days.
+func (p Period) TotalMonths() int {
+	return p.Years*12 + p.Months
+}
+
+// Normalized returns a copy of the Period with years and months normalized so that months is within -11..11.
+// Days are left untouched as the length of a month varies.
+func (p Period) Normalized() Period {
+	total := p.TotalMonths()
+	return Period{Years: total / 12, Months: total % 12, Days: p.Days}
+}
+
+// Negated returns a copy of the Period with each unit negated.
+func (p Period) Negated() Period {
+	return Period{Years: -p.Years, Months: -p.Months, Days: -p.Days}
+}
+
+// Plus returns a copy of the Period with the other Period added, unit by unit.
+func (p Period) Plus(other Period) Period {
+	return Period{Years: p.Years + other.Years, Months: p.Months + other.Months, Days: p.Days + other.Days}
+}
+
+// Minus returns a copy of the Period with the other Period subtracted, unit by unit.
+func (p Period) Minus(other Period) Period {
+	return p.Plus(other.Negated())
+}
+
+// String formats the Period in ISO 8601 notation, such as "P1Y2M3D". A zero Period is "P0D".
+func (p Period) String() string {
+	if p.IsZero() {
+		return "P0D"
+	}
+	var builder strings.Builder
+	builder.WriteByte('P')
+	if p.Years != 0 {
+		builder.WriteString(strconv.Itoa(p.Years))
+		builder.WriteByte('Y')
+	}
+	if p.Months != 0 {
+		builder.WriteString(strconv.Itoa(p.Months))
+		builder.WriteByte('M')
+	}
+	if p.Days != 0 {
+		builder.WriteString(strconv.Itoa(p.Days))
+		builder.WriteByte('D')
+	}
+	return builder.String()
+}
+
+// MarshalText implements encoding.TextMarshaler using the ISO 8601 notation.
+func (p Period) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the ISO 8601 notation.
+func (p *Period) UnmarshalText(text []byte) error {
+	if p == nil {
+		return errors.New("UnmarshalText on nil pointer")
+	}
+	parsed, err := ParsePeriod(string(text))
+	if err != nil {
+		return err
+	}
+	*p = parsed
+	return nil
+}
+
+// PlusPeriod adds the Period to the GDateTime, returning a new GDateTime instance.
+// Years and months are added first, clamping the day to the end of the month if needed, then days are added keeping the wall clock.
+func (gdt *GDateTime) PlusPeriod(p Period) *GDateTime {
+	year, month, day := plusMonthsClamped(gdt.t.Year(), int(gdt.t.Month()), gdt.t.Day(), p.TotalMonths())
+	newTime := time.Date(year, time.Month(month), day+p.Days, gdt.t.Hour(), gdt.t.Minute(), gdt.t.Second(), gdt.t.Nanosecond(), gdt.t.Location())
+	return Create(newTime)
+}
+
+// MinusPeriod subtracts the Period from the GDateTime, returning a new GDateTime instance.
+func (gdt *GDateTime) MinusPeriod(p Period) *GDateTime {
+	return gdt.PlusPeriod(p.Negated())
+}
@@ -0,0 +1,150 @@
+package gdatetime
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestPeriodBetween(t *testing.T) {
+	cases := []struct {
+		name     string
+		start    time.Time
+		end      time.Time
+		expected Period
+	}{
+		{"Same Day", time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC), time.Date(2024, 6, 3, 23, 0, 0, 0, time.UTC), Period{}},
+		{"Full Units", time.Date(2020, 1, 15, 0, 0, 0, 0, time.UTC), time.Date(2021, 3, 18, 0, 0, 0, 0, time.UTC), Period{1, 2, 3}},
+		{"Borrow Days", time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), Period{0, 1, 1}},
+		{"Age", time.Date(1990, 8, 20, 0, 0, 0, 0, time.UTC), time.Date(2024, 8, 19, 0, 0, 0, 0, time.UTC), Period{33, 11, 30}},
+		{"Negative", time.Date(2021, 3, 18, 0, 0, 0, 0, time.UTC), time.Date(2020, 1, 15, 0, 0, 0, 0, time.UTC), Period{-1, -2, -3}},
+		{"Negative Borrow", time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), Period{0, -1, -1}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := PeriodBetween(Create(tc.start), Create(tc.end)); got != tc.expected {
+				t.Errorf("PeriodBetween failed, expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestPeriodBetweenRoundTrip(t *testing.T) {
+	start := Create(time.Date(2023, 1, 31, 10, 0, 0, 0, time.UTC))
+	for days := 0; days < 800; days += 7 {
+		end := start.PlusDays(days)
+		p := PeriodBetween(start, end)
+		if !start.PlusPeriod(p).EqualDate(end) {
+			t.Fatalf("PlusPeriod(PeriodBetween) failed for %v: period %v gives %v", end.ToDateString(), p, start.PlusPeriod(p).ToDateString())
+		}
+	}
+}
+
+func TestParsePeriod(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected Period
+	}{
+		{"P1Y2M3D", Period{1, 2, 3}},
+		{"P2W", Period{0, 0, 14}},
+		{"P1M1W", Period{0, 1, 7}},
+		{"-P1Y2M", Period{-1, -2, 0}},
+		{"P-1Y2M", Period{-1, 2, 0}},
+		{"p3d", Period{0, 0, 3}},
+		{"P0D", Period{}},
+	}
+
+	for _, c := range cases {
+		got, err := ParsePeriod(c.input)
+		if err != nil {
+			t.Errorf("ParsePeriod(%q) returned error: %v", c.input, err)
+			continue
+		}
+		if got != c.expected {
+			t.Errorf("ParsePeriod(%q) failed, expected %v, got %v", c.input, c.expected, got)
+		}
+	}
+
+	for _, input := range []string{"", "P", "1Y", "P1D2M", "PT1H", "P1.5Y"} {
+		if _, err := ParsePeriod(input); err == nil {
+			t.Errorf("ParsePeriod(%q) should fail", input)
+		}
+	}
+}
+
+func TestPeriodString(t *testing.T) {
+	cases := []struct {
+		period   Period
+		expected string
+	}{
+		{Period{}, "P0D"},
+		{Period{1, 2, 3}, "P1Y2M3D"},
+		{Period{0, 14, 0}, "P14M"},
+		{Period{-1, 0, 5}, "P-1Y5D"},
+	}
+
+	for _, c := range cases {
+		if got := c.period.String(); got != c.expected {
+			t.Errorf("String() failed, expected %q, got %q", c.expected, got)
+		}
+	}
+}
+
+func TestPeriodArithmetic(t *testing.T) {
+	p := PeriodOf(1, 14, 40)
+	if got := p.Normalized(); got != (Period{2, 2, 40}) {
+		t.Errorf("Normalized failed, expected P2Y2M40D, got %v", got)
+	}
+	if got := PeriodOf(1, -13, 0).Normalized(); got != (Period{0, -1, 0}) {
+		t.Errorf("Normalized failed, expected P-1M, got %v", got)
+	}
+	if got := p.Negated(); got != (Period{-1, -14, -40}) {
+		t.Errorf("Negated failed, got %v", got)
+	}
+	if got := p.Plus(PeriodOf(1, 1, 1)); got != (Period{2, 15, 41}) {
+		t.Errorf("Plus failed, got %v", got)
+	}
+	if got := p.Minus(p); !got.IsZero() {
+		t.Errorf("Minus failed, expected zero, got %v", got)
+	}
+	if !PeriodOf(1, -1, 0).IsNegative() {
+		t.Errorf("IsNegative failed, expected true")
+	}
+}
+
+func TestPlusPeriod(t *testing.T) {
+	gdt := Create(time.Date(2024, 1, 31, 9, 30, 0, 0, time.UTC))
+
+	expected := time.Date(2024, 2, 29, 9, 30, 0, 0, time.UTC)
+	if got := gdt.PlusPeriod(PeriodOf(0, 1, 0)); got.t != expected {
+		t.Errorf("PlusPeriod failed, expected %v, got %v", expected, got.t)
+	}
+
+	expected = time.Date(2025, 3, 3, 9, 30, 0, 0, time.UTC)
+	if got := gdt.PlusPeriod(PeriodOf(1, 1, 3)); got.t != expected {
+		t.Errorf("PlusPeriod failed, expected %v, got %v", expected, got.t)
+	}
+
+	expected = time.Date(2023, 12, 30, 9, 30, 0, 0, time.UTC)
+	if got := gdt.MinusPeriod(PeriodOf(0, 1, 1)); got.t != expected {
+		t.Errorf("MinusPeriod failed, expected %v, got %v", expected, got.t)
+	}
+}
+
+func TestPeriodJSON(t *testing.T) {
+	data, err := json.Marshal(struct{ Length Period }{PeriodOf(1, 2, 3)})
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+	if string(data) != `{"Length":"P1Y2M3D"}` {
+		t.Errorf("Marshal failed, got %s", data)
+	}
+	var decoded struct{ Length Period }
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal returned error: %v", err)
+	}
+	if decoded.Length != PeriodOf(1, 2, 3) {
+		t.Errorf("Unmarshal failed, got %v", decoded.Length)
+	}
+}
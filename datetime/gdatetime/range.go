@@ -0,0 +1,120 @@
+package gdatetime
+
+import (
+	"iter"
+	"time"
+
+	"github.com/linsongze/go-date-time/datetime/timeunit"
+)
+
+// Range returns an iterator over start, start + step units, start + 2*step units, ... up to end, exclusive.
+// A negative step goes backward down to end, exclusive, and a zero step or an unknown unit yields nothing.
+// Every element is computed from start, not from the previous element:
+// days, weeks, months and years keep the wall clock across DST changes, and months and years clamp the day
+// without compounding, so Jan 31 by 1 month gives Jan 31, Feb 29, Mar 31, Apr 30.
+// Hours and smaller units are exact elapsed time, so stepping by hours never repeats or skips an instant across DST.
+func Range(start, end *GDateTime, step int, unit timeunit.TimeUnit) iter.Seq[*GDateTime] {
+	return func(yield func(*GDateTime) bool) {
+		if step == 0 {
+			return
+		}
+		for i := 0; ; i++ {
+			gdt, ok := stepFrom(start, i*step, unit)
+			if !ok || (step > 0 && !gdt.t.Before(end.t)) || (step < 0 && !gdt.t.After(end.t)) {
+				return
+			}
+			if !yield(gdt) {
+				return
+			}
+		}
+	}
+}
+
+// stepFrom returns start plus amount units as Range does, false for an unknown unit.
+func stepFrom(start *GDateTime, amount int, unit timeunit.TimeUnit) (*GDateTime, bool) {
+	if months, ok := monthsOf(unit); ok {
+		t := start.t
+		year, month, day := plusMonthsClamped(t.Year(), int(t.Month()), t.Day(), amount*months)
+		return Create(time.Date(year, time.Month(month), day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())), true
+	}
+	switch unit {
+	case timeunit.NANOS, timeunit.MICROS, timeunit.MILLIS, timeunit.SECONDS, timeunit.MINUTES, timeunit.HOURS,
+		timeunit.HALF_DAYS, timeunit.DAYS, timeunit.WEEKS:
+		return start.Plus(amount, unit), true
+	default:
+		return nil, false
+	}
+}
+
+// monthsOf returns the number of months in one unit for MONTHS and the longer units, false for the other units.
+func monthsOf(unit timeunit.TimeUnit) (int, bool) {
+	switch unit {
+	case timeunit.MONTHS:
+		return 1, true
+	case timeunit.QUARTERS:
+		return 3, true
+	case timeunit.HALF_YEARS:
+		return 6, true
+	case timeunit.YEARS:
+		return 12, true
+	case timeunit.DECADES:
+		return 120, true
+	case timeunit.CENTURIES:
+		return 1200, true
+	default:
+		return 0, false
+	}
+}
+
+// Split returns an iterator over the pieces of the interval cut at the calendar boundaries of the unit
+// on the wall clock of Start: midnight for DAYS, Monday for WEEKS, the first of the month for MONTHS.
+// The first and last pieces are clipped to the interval, so 22:00 to 02:00 split by DAYS gives 22:00 to 00:00 and 00:00 to 02:00.
+// An empty interval or an unknown unit yields nothing.
+func (i Interval) Split(unit timeunit.TimeUnit) iter.Seq[Interval] {
+	return func(yield func(Interval) bool) {
+		first, err := i.Start.FloorTo(1, unit)
+		if err != nil {
+			return
+		}
+		for piece := range unitIntervals(first, i.End, 1, unit) {
+			if clipped, ok := piece.Intersection(i); ok && !yield(clipped) {
+				return
+			}
+		}
+	}
+}
+
+// Days returns an iterator over the whole days overlapping the interval, as DayOf, from midnight to midnight.
+func (i Interval) Days() iter.Seq[Interval] {
+	return unitIntervals(DayOf(i.Start).Start, i.End, 1, timeunit.DAYS)
+}
+
+// Weeks returns an iterator over the whole weeks overlapping the interval, as WeekOf with weekStart.
+func (i Interval) Weeks(weekStart time.Weekday) iter.Seq[Interval] {
+	return unitIntervals(WeekOf(i.Start, weekStart).Start, i.End, 7, timeunit.DAYS)
+}
+
+// Months returns an iterator over the whole months overlapping the interval, as MonthOf.
+func (i Interval) Months() iter.Seq[Interval] {
+	return unitIntervals(MonthOf(i.Start).Start, i.End, 1, timeunit.MONTHS)
+}
+
+// Quarters returns an iterator over the whole quarters overlapping the interval, starting on January, April, July and October 1.
+func (i Interval) Quarters() iter.Seq[Interval] {
+	first, _ := i.Start.FloorTo(3, timeunit.MONTHS)
+	return unitIntervals(first, i.End, 3, timeunit.MONTHS)
+}
+
+// unitIntervals returns an iterator over the consecutive intervals of step units from first, the last one ending at or after end.
+func unitIntervals(first, end *GDateTime, step int, unit timeunit.TimeUnit) iter.Seq[Interval] {
+	return func(yield func(Interval) bool) {
+		start := first
+		for k := 1; start.t.Before(end.t); k++ {
+			next, _ := stepFrom(first, k*step, unit)
+			if !yield(Interval{Start: start, End: next}) {
+				return
+			}
+			start = next
+		}
+	}
+}
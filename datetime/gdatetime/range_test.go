@@ -0,0 +1,143 @@
+package gdatetime
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/linsongze/go-date-time/datetime/timeunit"
+)
+
+func rangeStrings(seq func(func(*GDateTime) bool)) []string {
+	var out []string
+	for gdt := range seq {
+		out = append(out, gdt.t.Format("2006-01-02 15:04 MST"))
+	}
+	return out
+}
+
+func TestRangeMonthsAnchored(t *testing.T) {
+	start := Create(time.Date(2024, 1, 31, 10, 0, 0, 0, time.UTC))
+	end := Create(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC))
+	got := rangeStrings(Range(start, end, 1, timeunit.MONTHS))
+	want := []string{"2024-01-31 10:00 UTC", "2024-02-29 10:00 UTC", "2024-03-31 10:00 UTC", "2024-04-30 10:00 UTC", "2024-05-31 10:00 UTC"}
+	if !slices.Equal(got, want) {
+		t.Errorf("Range by months = %v, want %v", got, want)
+	}
+
+	leap := Create(time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC))
+	got = rangeStrings(Range(leap, Create(time.Date(2029, 1, 1, 0, 0, 0, 0, time.UTC)), 4, timeunit.YEARS))
+	want = []string{"2024-02-29 00:00 UTC", "2028-02-29 00:00 UTC"}
+	if !slices.Equal(got, want) {
+		t.Errorf("Range by 4 years = %v, want %v", got, want)
+	}
+}
+
+func TestRangeBackwardAndEmpty(t *testing.T) {
+	start := utcAt(5, 0)
+	got := rangeStrings(Range(start, utcAt(2, 0), -1, timeunit.DAYS))
+	want := []string{"2024-06-05 00:00 UTC", "2024-06-04 00:00 UTC", "2024-06-03 00:00 UTC"}
+	if !slices.Equal(got, want) {
+		t.Errorf("Range backward = %v, want %v", got, want)
+	}
+	if got := rangeStrings(Range(start, utcAt(9, 0), 0, timeunit.DAYS)); len(got) != 0 {
+		t.Errorf("Range with a zero step = %v, want nothing", got)
+	}
+	if got := rangeStrings(Range(start, utcAt(9, 0), 1, timeunit.TimeUnit(-1))); len(got) != 0 {
+		t.Errorf("Range with an unknown unit = %v, want nothing", got)
+	}
+	if got := rangeStrings(Range(start, start, 1, timeunit.HOURS)); len(got) != 0 {
+		t.Errorf("Range with start equal to end = %v, want nothing", got)
+	}
+}
+
+func TestRangeAcrossDST(t *testing.T) {
+	ny := loadNewYork(t)
+	start := Create(time.Date(2024, 3, 10, 0, 0, 0, 0, ny))
+	got := rangeStrings(Range(start, Create(time.Date(2024, 3, 10, 4, 0, 0, 0, ny)), 1, timeunit.HOURS))
+	want := []string{"2024-03-10 00:00 EST", "2024-03-10 01:00 EST", "2024-03-10 03:00 EDT"}
+	if !slices.Equal(got, want) {
+		t.Errorf("Range by hours across the gap = %v, want %v", got, want)
+	}
+
+	got = rangeStrings(Range(Create(time.Date(2024, 3, 9, 9, 0, 0, 0, ny)), Create(time.Date(2024, 3, 12, 0, 0, 0, 0, ny)), 1, timeunit.DAYS))
+	want = []string{"2024-03-09 09:00 EST", "2024-03-10 09:00 EDT", "2024-03-11 09:00 EDT"}
+	if !slices.Equal(got, want) {
+		t.Errorf("Range by days across the gap = %v, want %v", got, want)
+	}
+}
+
+func TestIntervalSplit(t *testing.T) {
+	i := must(IntervalOf(utcAt(1, 22), utcAt(3, 2)))
+	var got []Interval
+	for piece := range i.Split(timeunit.DAYS) {
+		got = append(got, piece)
+	}
+	want := []Interval{
+		must(IntervalOf(utcAt(1, 22), utcAt(2, 0))),
+		must(IntervalOf(utcAt(2, 0), utcAt(3, 0))),
+		must(IntervalOf(utcAt(3, 0), utcAt(3, 2))),
+	}
+	if !slices.EqualFunc(got, want, Interval.IsEqual) {
+		t.Errorf("Split by days = %v, want %v", got, want)
+	}
+
+	count := 0
+	for range must(IntervalOf(utcAt(1, 0), utcAt(1, 0))).Split(timeunit.HOURS) {
+		count++
+	}
+	if count != 0 {
+		t.Errorf("Split of an empty interval yielded %d pieces", count)
+	}
+
+	ny := loadNewYork(t)
+	day := DayOf(Create(time.Date(2024, 11, 3, 12, 0, 0, 0, ny)))
+	hours := 0
+	for piece := range day.Split(timeunit.HOURS) {
+		hours++
+		if piece.Duration() != time.Hour {
+			t.Errorf("Split by hours gave %v", piece)
+		}
+	}
+	if hours != 25 {
+		t.Errorf("the day of the fall back should split into 25 hours, got %d", hours)
+	}
+}
+
+func TestIntervalCalendarIterators(t *testing.T) {
+	// Wednesday June 12 to Tuesday July 2, 2024
+	i := must(IntervalOf(utcAt(12, 15), Create(time.Date(2024, 7, 2, 8, 0, 0, 0, time.UTC))))
+
+	days := 0
+	for range i.Days() {
+		days++
+	}
+	if days != 21 {
+		t.Errorf("Days yielded %d days, want 21", days)
+	}
+
+	var weeks []Interval
+	for week := range i.Weeks(time.Monday) {
+		weeks = append(weeks, week)
+	}
+	if len(weeks) != 4 || !weeks[0].Start.t.Equal(utcAt(10, 0).t) || weeks[3].Start.t.Day() != 1 {
+		t.Errorf("Weeks = %v, want the 4 weeks from June 10", weeks)
+	}
+
+	var months []Interval
+	for month := range i.Months() {
+		months = append(months, month)
+	}
+	if len(months) != 2 || !months[0].IsEqual(MonthOf(utcAt(12, 0))) || months[1].Start.t.Month() != time.July {
+		t.Errorf("Months = %v, want June and July", months)
+	}
+
+	var quarters []Interval
+	for quarter := range i.Quarters() {
+		quarters = append(quarters, quarter)
+	}
+	q2 := must(IntervalOf(Create(time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)), Create(time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC))))
+	if len(quarters) != 2 || !quarters[0].IsEqual(q2) || quarters[1].End.t.Month() != time.October {
+		t.Errorf("Quarters = %v, want Q2 and Q3", quarters)
+	}
+}
@@ -0,0 +1,115 @@
+package gdatetime
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/linsongze/go-date-time/datetime/chronofield"
+	"github.com/linsongze/go-date-time/datetime/timeconst"
+)
+
+// ResolverStyle decides how the fields given to OfResolved are checked, modeled on java.time's ResolverStyle.
+type ResolverStyle int
+
+const (
+	// ResolverStrict rejects any field outside its range, including a day that does not exist in the month.
+	ResolverStrict ResolverStyle = iota
+	// ResolverSmart accepts a day of 1-31 and clamps it to the end of the month (Feb 31 gives Feb 28/29),
+	// and accepts 24:00:00 as midnight at the end of the day. Other fields are strict.
+	ResolverSmart
+	// ResolverLenient accepts any value and lets the overflow roll into the next field, like time.Date:
+	// Feb 31 gives Mar 2/3 and hour 25 gives 01:00 the next day.
+	ResolverLenient
+)
+
+// String returns the name of the ResolverStyle.
+func (s ResolverStyle) String() string {
+	switch s {
+	case ResolverStrict:
+		return "Strict"
+	case ResolverSmart:
+		return "Smart"
+	case ResolverLenient:
+		return "Lenient"
+	default:
+		return fmt.Sprintf("ResolverStyle(%d)", int(s))
+	}
+}
+
+// OfResolved Obtains an instance of GDateTime in the given location, checking the fields with the resolver style
+func OfResolved(style ResolverStyle, year, month, dayOfMonth, hour, minute, second, nanoOfSecond int, loc *time.Location) (*GDateTime, error) {
+	if loc == nil {
+		return nil, ErrNilLocation
+	}
+	switch style {
+	case ResolverStrict:
+		if err := checkDateFields(year, month, dayOfMonth); err != nil {
+			return nil, err
+		}
+		if err := checkTimeFields(hour, minute, second, nanoOfSecond); err != nil {
+			return nil, err
+		}
+	case ResolverSmart:
+		if err := checkDateFields(year, month, 1); err != nil {
+			return nil, err
+		}
+		if err := checkField(chronofield.DAY_OF_MONTH, dayOfMonth, 1, 31); err != nil {
+			return nil, err
+		}
+		if maxDay := DaysInMonth(year, month); dayOfMonth > maxDay {
+			dayOfMonth = maxDay
+		}
+		if hour == 24 && minute == 0 && second == 0 && nanoOfSecond == 0 {
+			t := time.Date(year, time.Month(month), dayOfMonth+1, 0, 0, 0, 0, loc)
+			return Create(t), nil
+		}
+		if err := checkTimeFields(hour, minute, second, nanoOfSecond); err != nil {
+			return nil, err
+		}
+	case ResolverLenient:
+	default:
+		return nil, fmt.Errorf("unknown resolver style %v", style)
+	}
+	t := time.Date(year, time.Month(month), dayOfMonth, hour, minute, second, nanoOfSecond, loc)
+	return Create(t), nil
+}
+
+// OfStrict Obtains an instance of GDateTime in the given location, rejecting any field outside its range
+func OfStrict(year, month, dayOfMonth, hour, minute, second, nanoOfSecond int, loc *time.Location) (*GDateTime, error) {
+	return OfResolved(ResolverStrict, year, month, dayOfMonth, hour, minute, second, nanoOfSecond, loc)
+}
+
+// OfSmart Obtains an instance of GDateTime in the given location, clamping the day to the end of the month
+func OfSmart(year, month, dayOfMonth, hour, minute, second, nanoOfSecond int, loc *time.Location) (*GDateTime, error) {
+	return OfResolved(ResolverSmart, year, month, dayOfMonth, hour, minute, second, nanoOfSecond, loc)
+}
+
+// OfLenient Obtains an instance of GDateTime in the given location, rolling any overflow into the next field
+func OfLenient(year, month, dayOfMonth, hour, minute, second, nanoOfSecond int, loc *time.Location) (*GDateTime, error) {
+	return OfResolved(ResolverLenient, year, month, dayOfMonth, hour, minute, second, nanoOfSecond, loc)
+}
+
+// checkDateFields validates year, month and day, the day against the length of the month.
+func checkDateFields(year, month, dayOfMonth int) error {
+	if err := checkField(chronofield.YEAR, year, timeconst.MIN_YEAR, timeconst.MAX_YEAR); err != nil {
+		return err
+	}
+	if err := checkField(chronofield.MONTH_OF_YEAR, month, 1, 12); err != nil {
+		return err
+	}
+	return checkField(chronofield.DAY_OF_MONTH, dayOfMonth, 1, DaysInMonth(year, month))
+}
+
+// checkTimeFields validates hour, minute, second and nanosecond.
+func checkTimeFields(hour, minute, second, nanoOfSecond int) error {
+	if err := checkField(chronofield.HOUR_OF_DAY, hour, 0, timeconst.MAX_HOUR); err != nil {
+		return err
+	}
+	if err := checkField(chronofield.MINUTE_OF_HOUR, minute, 0, timeconst.MAX_MINUTE); err != nil {
+		return err
+	}
+	if err := checkField(chronofield.SECOND_OF_MINUTE, second, 0, timeconst.MAX_SECOND); err != nil {
+		return err
+	}
+	return checkField(chronofield.NANO_OF_SECOND, nanoOfSecond, 0, timeconst.MAX_NANO)
+}
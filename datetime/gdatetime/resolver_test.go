@@ -0,0 +1,126 @@
+package gdatetime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/linsongze/go-date-time/datetime/chronofield"
+)
+
+func TestOfValidatesDayOfMonth(t *testing.T) {
+	_, err := Of(2024, 2, 31, 0, 0, 0, 0)
+	var rangeErr *FieldRangeError
+	if !errors.As(err, &rangeErr) {
+		t.Fatalf("Of(2024-02-31) should return a FieldRangeError, got %v", err)
+	}
+	if rangeErr.Field != chronofield.DAY_OF_MONTH || rangeErr.Value != 31 || rangeErr.Min != 1 || rangeErr.Max != 29 {
+		t.Errorf("unexpected FieldRangeError %+v", rangeErr)
+	}
+
+	if _, err := Of(2023, 2, 29, 0, 0, 0, 0); err == nil {
+		t.Error("Of(2023-02-29) should fail in a non-leap year")
+	}
+	if _, err := Of(2024, 2, 29, 0, 0, 0, 0); err != nil {
+		t.Errorf("Of(2024-02-29) returned error: %v", err)
+	}
+}
+
+func TestOfFieldErrors(t *testing.T) {
+	cases := []struct {
+		field chronofield.ChronoField
+		args  [7]int
+	}{
+		{chronofield.MONTH_OF_YEAR, [7]int{2024, 13, 1, 0, 0, 0, 0}},
+		{chronofield.HOUR_OF_DAY, [7]int{2024, 1, 1, 24, 0, 0, 0}},
+		{chronofield.MINUTE_OF_HOUR, [7]int{2024, 1, 1, 0, 60, 0, 0}},
+		{chronofield.SECOND_OF_MINUTE, [7]int{2024, 1, 1, 0, 0, -1, 0}},
+		{chronofield.NANO_OF_SECOND, [7]int{2024, 1, 1, 0, 0, 0, 1000000000}},
+	}
+
+	for _, c := range cases {
+		a := c.args
+		_, err := Of(a[0], a[1], a[2], a[3], a[4], a[5], a[6])
+		var rangeErr *FieldRangeError
+		if !errors.As(err, &rangeErr) || rangeErr.Field != c.field {
+			t.Errorf("Of(%v) should fail on %s, got %v", a, c.field, err)
+		}
+	}
+}
+
+func TestOfNegativeYear(t *testing.T) {
+	gdt, err := OfInLocation(-44, 3, 15, 12, 0, 0, 0, time.UTC)
+	if err != nil {
+		t.Fatalf("OfInLocation returned error: %v", err)
+	}
+	if gdt.GetYear() != -44 {
+		t.Errorf("OfInLocation failed, expected year -44, got %d", gdt.GetYear())
+	}
+}
+
+func TestOfInLocation(t *testing.T) {
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Skip("Asia/Shanghai not available")
+	}
+	gdt, err := OfInLocation(2024, 6, 3, 10, 15, 30, 0, shanghai)
+	if err != nil {
+		t.Fatalf("OfInLocation returned error: %v", err)
+	}
+	expected := time.Date(2024, 6, 3, 10, 15, 30, 0, shanghai)
+	if gdt.t != expected {
+		t.Errorf("OfInLocation failed, expected %v, got %v", expected, gdt.t)
+	}
+	if _, err := OfInLocation(2024, 6, 3, 10, 15, 30, 0, nil); err == nil {
+		t.Error("OfInLocation should fail with a nil location")
+	}
+}
+
+func TestResolverStyles(t *testing.T) {
+	cases := []struct {
+		name     string
+		style    ResolverStyle
+		args     [7]int
+		expected time.Time
+		wantErr  bool
+	}{
+		{"Strict Feb 31", ResolverStrict, [7]int{2023, 2, 31, 0, 0, 0, 0}, time.Time{}, true},
+		{"Smart Feb 31", ResolverSmart, [7]int{2023, 2, 31, 8, 0, 0, 0}, time.Date(2023, 2, 28, 8, 0, 0, 0, time.UTC), false},
+		{"Smart Day 32", ResolverSmart, [7]int{2023, 1, 32, 0, 0, 0, 0}, time.Time{}, true},
+		{"Smart 24:00", ResolverSmart, [7]int{2023, 12, 31, 24, 0, 0, 0}, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), false},
+		{"Smart 24:01", ResolverSmart, [7]int{2023, 12, 31, 24, 1, 0, 0}, time.Time{}, true},
+		{"Smart Month 13", ResolverSmart, [7]int{2023, 13, 1, 0, 0, 0, 0}, time.Time{}, true},
+		{"Lenient Feb 31", ResolverLenient, [7]int{2023, 2, 31, 0, 0, 0, 0}, time.Date(2023, 3, 3, 0, 0, 0, 0, time.UTC), false},
+		{"Lenient Hour 25", ResolverLenient, [7]int{2023, 12, 31, 25, 0, 0, 0}, time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC), false},
+		{"Lenient Month 13", ResolverLenient, [7]int{2023, 13, 1, 0, 0, 0, 0}, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := tc.args
+			got, err := OfResolved(tc.style, a[0], a[1], a[2], a[3], a[4], a[5], a[6], time.UTC)
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("OfResolved should fail, got %v", got.t)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("OfResolved returned error: %v", err)
+			}
+			if got.t != tc.expected {
+				t.Errorf("OfResolved failed, expected %v, got %v", tc.expected, got.t)
+			}
+		})
+	}
+
+	if got, _ := OfSmart(2024, 4, 31, 0, 0, 0, 0, time.UTC); got.GetDayOfMonth() != 30 {
+		t.Errorf("OfSmart failed, expected day 30, got %d", got.GetDayOfMonth())
+	}
+	if _, err := OfStrict(2024, 4, 31, 0, 0, 0, 0, time.UTC); err == nil {
+		t.Error("OfStrict should fail for Apr 31")
+	}
+	if got, _ := OfLenient(2024, 4, 31, 0, 0, 0, 0, time.UTC); got.GetMonth() != 5 {
+		t.Errorf("OfLenient failed, expected May, got %d", got.GetMonth())
+	}
+}
@@ -0,0 +1,179 @@
+package gdatetime
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/linsongze/go-date-time/datetime/timeunit"
+)
+
+// RoundingMode decides which of the two boundaries around a GDateTime RoundToWith returns.
+type RoundingMode int
+
+const (
+	// RoundHalfUp returns the nearest boundary, the later one when both are as near: 10:07:30 to 15 minutes gives 10:15.
+	RoundHalfUp RoundingMode = iota
+	// RoundHalfEven returns the nearest boundary, the one at an even multiple of the step when both are as near,
+	// so halfway values do not all round the same way.
+	RoundHalfEven
+	// RoundUp returns the boundary at or after the GDateTime, like CeilTo.
+	RoundUp
+	// RoundDown returns the boundary at or before the GDateTime, like FloorTo.
+	RoundDown
+)
+
+// String returns the name of the RoundingMode.
+func (m RoundingMode) String() string {
+	switch m {
+	case RoundHalfUp:
+		return "HalfUp"
+	case RoundHalfEven:
+		return "HalfEven"
+	case RoundUp:
+		return "Up"
+	case RoundDown:
+		return "Down"
+	default:
+		return fmt.Sprintf("RoundingMode(%d)", int(m))
+	}
+}
+
+// RoundTo rounds the GDateTime to the nearest multiple of n units on its wall clock, halfway values rounding up.
+// See RoundToWith.
+func (gdt *GDateTime) RoundTo(n int, unit timeunit.TimeUnit) (*GDateTime, error) {
+	return gdt.RoundToWith(n, unit, RoundHalfUp)
+}
+
+// CeilTo returns the first multiple of n units on the wall clock at or after the GDateTime. See RoundToWith.
+func (gdt *GDateTime) CeilTo(n int, unit timeunit.TimeUnit) (*GDateTime, error) {
+	return gdt.RoundToWith(n, unit, RoundUp)
+}
+
+// FloorTo returns the last multiple of n units on the wall clock at or before the GDateTime, such as the start
+// of its 5-minute bucket. See RoundToWith.
+func (gdt *GDateTime) FloorTo(n int, unit timeunit.TimeUnit) (*GDateTime, error) {
+	return gdt.RoundToWith(n, unit, RoundDown)
+}
+
+// RoundToWith rounds the GDateTime to a multiple of n units with the mode. The boundaries are on the local wall clock,
+// so 15 minutes gives :00, :15, :30 and :45 local time even in a zone with a 30 or 45 minute offset:
+//   - units up to HALF_DAYS count from local midnight, and a step that does not divide the day restarts at midnight;
+//   - DAYS count from 1970-01-01 and WEEKS from Monday 1970-01-05;
+//   - MONTHS, QUARTERS and HALF_YEARS count from January, so 3 MONTHS gives quarters,
+//     and YEARS, DECADES and CENTURIES from year 0, so a century is 2000 to 2099.
+//
+// Halfway is measured on the wall clock. A boundary skipped by a DST change moves to the transition,
+// and a boundary repeated by one keeps the offset of the GDateTime when it can.
+// A step n below 1 or an unknown unit or mode returns an error.
+func (gdt *GDateTime) RoundToWith(n int, unit timeunit.TimeUnit, mode RoundingMode) (*GDateTime, error) {
+	if n < 1 {
+		return nil, fmt.Errorf("rounding step %d must be positive", n)
+	}
+	if mode < RoundHalfUp || mode > RoundDown {
+		return nil, fmt.Errorf("unknown rounding mode %v", mode)
+	}
+	wall := wallClock(gdt.t)
+	floor, ceil, index, ok := wallBoundaries(wall, n, unit)
+	if !ok {
+		return nil, fmt.Errorf("unsupported rounding unit %d", int(unit))
+	}
+
+	rounded := floor
+	if !wall.Equal(floor) {
+		switch mode {
+		case RoundUp:
+			rounded = ceil
+		case RoundHalfUp, RoundHalfEven:
+			// compare wall - floor with ceil - wall without a time.Duration, which cannot hold long steps
+			seconds := 2*wall.Unix() - floor.Unix() - ceil.Unix()
+			nanos := int64(2*wall.Nanosecond() - floor.Nanosecond() - ceil.Nanosecond())
+			seconds += int64(floorDiv(int(nanos), 1e9))
+			nanos = int64(floorMod(int(nanos), 1e9))
+			half := seconds == 0 && nanos == 0
+			if seconds > 0 || (!half && seconds == 0) || (half && (mode == RoundHalfUp || index%2 != 0)) {
+				rounded = ceil
+			}
+		}
+	}
+	return Create(wallToLocation(rounded, gdt.t)), nil
+}
+
+// wallClock returns the wall clock of t as a time in UTC.
+func wallClock(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+// wallBoundaries returns the multiples of n units on the wall clock at or before and after the wall clock,
+// given as a time in UTC, and the count of the one before from the origin of the unit. It returns false for an unknown unit.
+func wallBoundaries(wall time.Time, n int, unit timeunit.TimeUnit) (time.Time, time.Time, int, bool) {
+	year, month, day := wall.Date()
+	var step time.Duration
+	switch unit {
+	case timeunit.NANOS:
+		step = time.Nanosecond
+	case timeunit.MICROS:
+		step = time.Microsecond
+	case timeunit.MILLIS:
+		step = time.Millisecond
+	case timeunit.SECONDS:
+		step = time.Second
+	case timeunit.MINUTES:
+		step = time.Minute
+	case timeunit.HOURS:
+		step = time.Hour
+	case timeunit.HALF_DAYS:
+		step = 12 * time.Hour
+	case timeunit.DAYS, timeunit.WEEKS:
+		days, origin := n, int64(0)
+		if unit == timeunit.WEEKS {
+			// 1970-01-05 is a Monday
+			days, origin = 7*n, 4
+		}
+		index := floorDiv(int(epochDay(year, int(month), day)-origin), days)
+		floor := time.Unix((int64(index*days)+origin)*86400, 0).UTC()
+		return floor, floor.AddDate(0, 0, days), index, true
+	case timeunit.MONTHS, timeunit.QUARTERS, timeunit.HALF_YEARS, timeunit.YEARS, timeunit.DECADES, timeunit.CENTURIES:
+		months, _ := monthsOf(unit)
+		months *= n
+		index := floorDiv(year*12+int(month)-1, months)
+		first := index * months
+		floor := time.Date(floorDiv(first, 12), time.Month(floorMod(first, 12)+1), 1, 0, 0, 0, 0, time.UTC)
+		return floor, floor.AddDate(0, months, 0), index, true
+	default:
+		return time.Time{}, time.Time{}, 0, false
+	}
+
+	midnight := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	nextMidnight := midnight.AddDate(0, 0, 1)
+	if step > 24*time.Hour/time.Duration(n) {
+		// the step is longer than a day, every day has a single bucket
+		return midnight, nextMidnight, 0, true
+	}
+	step *= time.Duration(n)
+	index := wall.Sub(midnight) / step
+	floor := midnight.Add(index * step)
+	ceil := floor.Add(step)
+	if ceil.After(nextMidnight) {
+		ceil = nextMidnight
+	}
+	return floor, ceil, int(index), true
+}
+
+// wallToLocation returns the instant of the wall clock, given as a time in UTC, in the location of like.
+// A wall clock skipped by a DST change gives the transition, and a repeated one the instant with the offset of like if any.
+func wallToLocation(wall time.Time, like time.Time) time.Time {
+	loc := like.Location()
+	ldt := localDateTimeOf(wall)
+	instants, _, _ := localInstants(ldt, loc)
+	switch len(instants) {
+	case 0:
+		t, _ := resolveLocal(ldt, loc, DSTShiftForward)
+		return t
+	case 2:
+		_, offset := like.Zone()
+		if _, later := instants[1].Zone(); later == offset {
+			return instants[1]
+		}
+	}
+	return instants[0]
+}
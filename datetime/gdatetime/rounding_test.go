@@ -0,0 +1,101 @@
+package gdatetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/linsongze/go-date-time/datetime/timeunit"
+)
+
+func TestRoundToWith(t *testing.T) {
+	at := func(hour, minute, second int) *GDateTime {
+		return Create(time.Date(2024, 6, 1, hour, minute, second, 0, time.UTC))
+	}
+	tests := []struct {
+		name string
+		gdt  *GDateTime
+		n    int
+		unit timeunit.TimeUnit
+		mode RoundingMode
+		want *GDateTime
+	}{
+		{"half up below half", at(10, 7, 29), 15, timeunit.MINUTES, RoundHalfUp, at(10, 0, 0)},
+		{"half up at half", at(10, 7, 30), 15, timeunit.MINUTES, RoundHalfUp, at(10, 15, 0)},
+		{"half even at half to even", at(10, 22, 30), 15, timeunit.MINUTES, RoundHalfEven, at(10, 30, 0)},
+		{"half even at half to odd", at(10, 7, 30), 15, timeunit.MINUTES, RoundHalfEven, at(10, 0, 0)},
+		{"half even above half", at(10, 7, 31), 15, timeunit.MINUTES, RoundHalfEven, at(10, 15, 0)},
+		{"up", at(10, 0, 1), 5, timeunit.MINUTES, RoundUp, at(10, 5, 0)},
+		{"up on a boundary", at(10, 5, 0), 5, timeunit.MINUTES, RoundUp, at(10, 5, 0)},
+		{"down", at(10, 4, 59), 5, timeunit.MINUTES, RoundDown, at(10, 0, 0)},
+		{"up to the next day", at(23, 50, 0), 15, timeunit.MINUTES, RoundUp, Create(time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC))},
+		{"step not dividing the day", at(23, 0, 0), 7, timeunit.HOURS, RoundUp, Create(time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC))},
+		{"days", at(13, 0, 0), 1, timeunit.DAYS, RoundHalfUp, Create(time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC))},
+		{"weeks start on Monday", at(13, 0, 0), 1, timeunit.WEEKS, RoundDown, Create(time.Date(2024, 5, 27, 0, 0, 0, 0, time.UTC))},
+		{"quarters", at(13, 0, 0), 3, timeunit.MONTHS, RoundDown, Create(time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC))},
+		{"quarters rounded", at(13, 0, 0), 3, timeunit.MONTHS, RoundHalfUp, Create(time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC))},
+		{"decades", at(13, 0, 0), 10, timeunit.YEARS, RoundUp, Create(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))},
+		{"millis", Create(time.Date(2024, 6, 1, 0, 0, 0, 1_500_000, time.UTC)), 1, timeunit.MILLIS, RoundHalfEven, Create(time.Date(2024, 6, 1, 0, 0, 0, 2_000_000, time.UTC))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.gdt.RoundToWith(tt.n, tt.unit, tt.mode)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.t.Equal(tt.want.t) {
+				t.Errorf("RoundToWith(%d, %v, %v) = %v, want %v", tt.n, tt.unit, tt.mode, got.t, tt.want.t)
+			}
+		})
+	}
+}
+
+func TestRoundToLocalWallClock(t *testing.T) {
+	// India is UTC+05:30, hours on its wall clock are half hours in UTC
+	kolkata := time.FixedZone("IST", 5*3600+1800)
+	gdt := Create(time.Date(2024, 6, 1, 10, 20, 0, 0, kolkata))
+	floor, _ := gdt.FloorTo(1, timeunit.HOURS)
+	if want := time.Date(2024, 6, 1, 10, 0, 0, 0, kolkata); !floor.t.Equal(want) {
+		t.Errorf("FloorTo(1, HOURS) = %v, want %v", floor.t, want)
+	}
+	if truncated := gdt.TruncateTo(timeunit.HOURS); !truncated.t.Equal(floor.t) {
+		t.Errorf("TruncateTo(HOURS) = %v, want %v", truncated.t, floor.t)
+	}
+
+	nepal := time.FixedZone("NPT", 5*3600+2700)
+	ceil, _ := Create(time.Date(2024, 6, 1, 10, 1, 0, 0, nepal)).CeilTo(15, timeunit.MINUTES)
+	if want := time.Date(2024, 6, 1, 10, 15, 0, 0, nepal); !ceil.t.Equal(want) {
+		t.Errorf("CeilTo(15, MINUTES) = %v, want %v", ceil.t, want)
+	}
+}
+
+func TestRoundToAcrossDST(t *testing.T) {
+	ny := loadNewYork(t)
+	// 02:00 does not exist on 2024-03-10, the bucket starts at the transition
+	floor, _ := Create(time.Date(2024, 3, 10, 3, 10, 0, 0, ny)).FloorTo(2, timeunit.HOURS)
+	if want := time.Date(2024, 3, 10, 3, 0, 0, 0, ny); !floor.t.Equal(want) {
+		t.Errorf("FloorTo(2, HOURS) in the gap = %v, want %v", floor.t, want)
+	}
+
+	// 01:00 to 02:00 occurs twice on 2024-11-03, the second time in EST
+	est := time.Date(2024, 11, 3, 6, 40, 0, 0, time.UTC).In(ny)
+	floor, _ = Create(est).FloorTo(30, timeunit.MINUTES)
+	if want := time.Date(2024, 11, 3, 6, 30, 0, 0, time.UTC); !floor.t.Equal(want) {
+		t.Errorf("FloorTo(30, MINUTES) in the overlap = %v, want %v", floor.t, want)
+	}
+}
+
+func TestRoundToErrors(t *testing.T) {
+	gdt := utcAt(1, 0)
+	if _, err := gdt.RoundTo(0, timeunit.MINUTES); err == nil {
+		t.Error("RoundTo with a zero step should fail")
+	}
+	if _, err := gdt.RoundTo(1, timeunit.TimeUnit(99)); err == nil {
+		t.Error("RoundTo with an unknown unit should fail")
+	}
+	if _, err := gdt.RoundToWith(1, timeunit.HOURS, RoundingMode(9)); err == nil {
+		t.Error("RoundToWith with an unknown mode should fail")
+	}
+	if gdt.TruncateTo(timeunit.TimeUnit(99)) != gdt {
+		t.Error("TruncateTo with an unknown unit should return the GDateTime")
+	}
+}
@@ -58,21 +58,12 @@ var longMonthNames = []string{
 	"December",
 }
 
-func weekNumber(t *time.Time, char int) int {
-	weekday := int(t.Weekday())
-
-	if char == 'W' {
-		// Monday as the first day of the week
-		if weekday == 0 {
-			weekday = 6
-		} else {
-			weekday -= 1
-		}
-	}
-
-	return (t.YearDay() + 6 - weekday) / 7
-}
 func formatDateElement(t *time.Time, elem rune) string {
+	return formatElement(t, elem, true)
+}
+
+// formatElement formats one directive, zoned is false for the zone-less types where %z and %Z are empty.
+func formatElement(t *time.Time, elem rune, zoned bool) string {
 	switch elem {
 	case 'a':
 		return shortDayNames[t.Weekday()]
@@ -112,15 +103,23 @@ func formatDateElement(t *time.Time, elem rune) string {
 	case 'f':
 		return fmt.Sprintf("%06d", t.Nanosecond()/1000)
 	case 'z':
+		if !zoned {
+			return ""
+		}
 		return t.Format("-0700")
 	case 'Z':
+		if !zoned {
+			return ""
+		}
 		return t.Format("MST")
 	case 'j':
 		return fmt.Sprintf("%03d", t.YearDay())
 	case 'U':
-		return fmt.Sprintf("%02d", weekNumber(t, 'U'))
+		return fmt.Sprintf("%02d", Create(*t).WeekOfYear(weekFieldsSundayFull))
 	case 'W':
-		return fmt.Sprintf("%02d", weekNumber(t, 'W'))
+		return fmt.Sprintf("%02d", Create(*t).WeekOfYear(weekFieldsMondayFull))
+	case 'V':
+		return fmt.Sprintf("%02d", Create(*t).WeekOfWeekBasedYear(WeekFieldsISO))
 	case 'c':
 		return t.Format("Mon Jan 2 15:04:05 2006")
 	case 'x':
@@ -134,13 +133,18 @@ func formatDateElement(t *time.Time, elem rune) string {
 
 // Strftime like C style datez format
 func (gdt *GDateTime) Strftime(f string) string {
+	return strftime(&gdt.t, f, true)
+}
+
+// strftime formats t with the C style format f, zoned is false for the zone-less types where %z and %Z are empty.
+func strftime(t *time.Time, f string, zoned bool) string {
 	var builder strings.Builder
 	format := []rune(f)
 
 	for i := 0; i < len(format); i++ {
 		if format[i] == '%' && i < len(format)-1 {
 			i++
-			formatted := formatDateElement(&gdt.t, format[i])
+			formatted := formatElement(t, format[i], zoned)
 			if format[i] == '%' { // Handle the '%%' case
 				builder.WriteRune('%')
 			} else {
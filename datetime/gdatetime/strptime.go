@@ -0,0 +1,272 @@
+package gdatetime
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// zoneOffsetPattern matches the text read by %z, such as "+0800", "+08:00", "UTC+8", "GMT-05:30" or "Z".
+var zoneOffsetPattern = regexp.MustCompile(`^(?:(?i:UTC|GMT|UT)?[+-][0-9]{1,2}(?::?[0-9]{2}){0,2}|(?i:UTC|GMT|UT|Z))`)
+
+// numericDirectives are the directives reading a number first, which end a %Y at 4 digits.
+const numericDirectives = "dmyYHIMSfjUWVwxX"
+
+// Strptime parses the value with a C style format, the inverse of Strftime, using DefaultZoneResolver for %Z.
+// See ZoneResolver.Strptime.
+func Strptime(value, f string) (*GDateTime, error) {
+	return DefaultZoneResolver.Strptime(value, f)
+}
+
+// strptimeFields collects the values read by the directives of a format.
+type strptimeFields struct {
+	year, month, day, yearDay  int
+	hour, minute, second, nano int
+	pm, hasPM, hasYearDay      bool
+	hasDate                    bool
+	loc                        *time.Location
+	abbreviation               string // the %Z text when it was resolved as an abbreviation
+}
+
+// Strptime parses the value with a C style format, the inverse of Strftime, with the directives of Striftime.md.
+// Fields missing from the format default to 1900-01-01 00:00:00, and the result is in UTC unless the format has %z or %Z.
+// %z reads a fixed offset such as "+0800", "+08:00" or "UTC+8". %Z reads a zone name up to the next literal of the format
+// and resolves it with the ZoneResolver, so "Asia/Shanghai", "China Standard Time", "CST" and "GMT-05:30" are all accepted.
+// An abbreviation keeps its own offset, so "EST" in July gives 13:00 EDT for 12:00 EST.
+// %U, %W and %V are read and ignored. A failure is returned as a *ParseError.
+func (r *ZoneResolver) Strptime(value, f string) (*GDateTime, error) {
+	fields := strptimeFields{year: 1900, month: 1, day: 1}
+	pos, err := r.strptime(value, f, 0, &fields)
+	if err != nil {
+		return nil, err
+	}
+	if pos < len(value) {
+		return nil, &ParseError{Input: value, Layout: f, Offset: pos, Reason: fmt.Sprintf("extra text %q", value[pos:])}
+	}
+
+	if fields.hasPM {
+		if fields.hour < 1 || fields.hour > 12 {
+			return nil, &ParseError{Input: value, Layout: f, Offset: -1, Reason: fmt.Sprintf("hour %d out of range for %%I", fields.hour)}
+		}
+		fields.hour %= 12
+		if fields.pm {
+			fields.hour += 12
+		}
+	}
+	var date LocalDate
+	if fields.hasYearDay && !fields.hasDate {
+		if err := checkYear(fields.year); err != nil {
+			return nil, &ParseError{Input: value, Layout: f, Offset: -1, Reason: err.Error(), Err: err}
+		}
+		date, err = Year{year: fields.year}.AtDay(fields.yearDay)
+	} else {
+		date, err = LocalDateOf(fields.year, fields.month, fields.day)
+	}
+	if err != nil {
+		return nil, &ParseError{Input: value, Layout: f, Offset: -1, Reason: err.Error(), Err: err}
+	}
+	t, err := LocalTimeOf(fields.hour, fields.minute, fields.second, fields.nano)
+	if err != nil {
+		return nil, &ParseError{Input: value, Layout: f, Offset: -1, Reason: err.Error(), Err: err}
+	}
+	ldt := date.AtTime(t)
+
+	loc := fields.loc
+	if loc == nil {
+		loc = time.UTC
+	}
+	if fields.abbreviation != "" {
+		if offset, ok := abbreviationOffset(loc, fields.abbreviation, date.year); ok {
+			fixed := time.FixedZone(strings.ToUpper(fields.abbreviation), offset)
+			return Create(time.Date(date.year, time.Month(date.month), date.day, t.hour, t.minute, t.second, t.nano, fixed).In(loc)), nil
+		}
+	}
+	resolved, _ := resolveLocal(ldt, loc, DSTEarlierOffset)
+	return Create(resolved), nil
+}
+
+// strptime reads the value from pos with the format f into the fields, returning the position after the text read.
+func (r *ZoneResolver) strptime(value, f string, pos int, fields *strptimeFields) (int, error) {
+	fail := func(at int, reason string) (int, error) {
+		return at, &ParseError{Input: value, Layout: f, Offset: at, Reason: reason}
+	}
+	for i := 0; i < len(f); i++ {
+		c := f[i]
+		if c != '%' || i == len(f)-1 {
+			if unicode.IsSpace(rune(c)) {
+				// a space in the format matches any run of spaces, including none
+				for pos < len(value) && unicode.IsSpace(rune(value[pos])) {
+					pos++
+				}
+				continue
+			}
+			if pos >= len(value) || value[pos] != c {
+				return fail(pos, fmt.Sprintf("expected %q", c))
+			}
+			pos++
+			continue
+		}
+		i++
+		elem := f[i]
+		var err error
+		switch elem {
+		case '%':
+			if pos >= len(value) || value[pos] != '%' {
+				return fail(pos, `expected "%"`)
+			}
+			pos++
+		case 'a', 'A':
+			_, pos, err = readName(value, pos, longDayNames, shortDayNames)
+		case 'b', 'B':
+			fields.month, pos, err = readName(value, pos, longMonthNames[1:], shortMonthNames[1:])
+			fields.month++
+			fields.hasDate = true
+		case 'w':
+			_, pos, err = readNumber(value, pos, 1, 1)
+		case 'd':
+			fields.day, pos, err = readNumber(value, pos, 1, 2)
+			fields.hasDate = true
+		case 'm':
+			fields.month, pos, err = readNumber(value, pos, 1, 2)
+			fields.hasDate = true
+		case 'y':
+			fields.year, pos, err = readNumber(value, pos, 2, 2)
+			// POSIX: 69-99 are 1969-1999 and 00-68 are 2000-2068
+			if fields.year < 69 {
+				fields.year += 2000
+			} else {
+				fields.year += 1900
+			}
+		case 'Y':
+			sign := 1
+			if pos < len(value) && (value[pos] == '-' || value[pos] == '+') {
+				if value[pos] == '-' {
+					sign = -1
+				}
+				pos++
+			}
+			maxDigits := 9
+			if i+2 < len(f) && f[i+1] == '%' && strings.IndexByte(numericDirectives, f[i+2]) >= 0 {
+				// like C strptime, a year followed by a number such as "%Y%m%d" has 4 digits
+				maxDigits = 4
+			}
+			fields.year, pos, err = readNumber(value, pos, 1, maxDigits)
+			fields.year *= sign
+		case 'H':
+			fields.hour, pos, err = readNumber(value, pos, 1, 2)
+		case 'I':
+			fields.hour, pos, err = readNumber(value, pos, 1, 2)
+			fields.hasPM = true
+		case 'p':
+			var index int
+			index, pos, err = readName(value, pos, []string{"AM", "PM"})
+			fields.pm, fields.hasPM = index == 1, true
+		case 'M':
+			fields.minute, pos, err = readNumber(value, pos, 1, 2)
+		case 'S':
+			fields.second, pos, err = readNumber(value, pos, 1, 2)
+		case 'f':
+			start := pos
+			fields.nano, pos, err = readNumber(value, pos, 1, 9)
+			for digits := pos - start; err == nil && digits < 9; digits++ {
+				fields.nano *= 10
+			}
+		case 'j':
+			fields.yearDay, pos, err = readNumber(value, pos, 1, 3)
+			fields.hasYearDay = true
+		case 'U', 'W', 'V':
+			_, pos, err = readNumber(value, pos, 1, 2)
+		case 'z':
+			text := zoneOffsetPattern.FindString(value[pos:])
+			if text == "" {
+				return fail(pos, "expected a zone offset")
+			}
+			if fields.loc, err = ParseFixedZone(text); err != nil {
+				return fail(pos, err.Error())
+			}
+			fields.abbreviation = ""
+			pos += len(text)
+		case 'Z':
+			text, loc, kind, resolveErr := r.readZone(value, pos, f[i+1:])
+			if resolveErr != nil {
+				return pos, &ParseError{Input: value, Layout: f, Offset: pos, Reason: "unknown time zone", Err: resolveErr}
+			}
+			fields.loc, fields.abbreviation = loc, ""
+			if kind == zoneAbbreviation {
+				fields.abbreviation = text
+			}
+			pos += len(text)
+		case 'c':
+			pos, err = r.strptime(value, "%a %b %d %H:%M:%S %Y", pos, fields)
+		case 'x':
+			pos, err = r.strptime(value, "%m/%d/%y", pos, fields)
+		case 'X':
+			pos, err = r.strptime(value, "%H:%M:%S", pos, fields)
+		default:
+			return fail(pos, fmt.Sprintf("unsupported directive %%%c", elem))
+		}
+		if err != nil {
+			var parseErr *ParseError
+			if errors.As(err, &parseErr) {
+				parseErr.Layout = f
+				return pos, parseErr
+			}
+			return fail(pos, fmt.Sprintf("%%%c: %s", elem, err))
+		}
+	}
+	return pos, nil
+}
+
+// readNumber reads an unsigned decimal number of minDigits to maxDigits digits at pos.
+func readNumber(value string, pos, minDigits, maxDigits int) (int, int, error) {
+	n, digits := 0, 0
+	for pos+digits < len(value) && digits < maxDigits && value[pos+digits] >= '0' && value[pos+digits] <= '9' {
+		n = n*10 + int(value[pos+digits]-'0')
+		digits++
+	}
+	if digits < minDigits {
+		return 0, pos, fmt.Errorf("expected %d digits", minDigits)
+	}
+	return n, pos + digits, nil
+}
+
+// readName reads one of the names at pos ignoring case, trying each list in order, and returns its index.
+func readName(value string, pos int, lists ...[]string) (int, int, error) {
+	for _, names := range lists {
+		for index, name := range names {
+			if len(value)-pos >= len(name) && strings.EqualFold(value[pos:pos+len(name)], name) {
+				return index, pos + len(name), nil
+			}
+		}
+	}
+	return 0, pos, fmt.Errorf("expected one of %s", strings.Join(lists[0], ", "))
+}
+
+// readZone reads the zone name at pos for %Z, which ends before the next literal of the rest of the format,
+// or before a space when a directive follows. As a name such as "China Standard Time" may contain that literal,
+// the longest text that resolves wins.
+func (r *ZoneResolver) readZone(value string, pos int, rest string) (string, *time.Location, zoneKind, error) {
+	isEnd := unicode.IsSpace
+	if rest != "" && rest[0] != '%' && !unicode.IsSpace(rune(rest[0])) {
+		isEnd = func(c rune) bool { return c == rune(rest[0]) }
+	}
+	ends := []int{len(value)}
+	for end := len(value) - 1; end > pos; end-- {
+		if isEnd(rune(value[end])) {
+			ends = append(ends, end)
+		}
+	}
+	var err error
+	for _, end := range ends {
+		text := value[pos:end]
+		var loc *time.Location
+		var kind zoneKind
+		if loc, kind, err = r.resolve(text); err == nil {
+			return text, loc, kind, nil
+		}
+	}
+	return "", nil, zoneIANA, err
+}
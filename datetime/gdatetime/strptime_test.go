@@ -0,0 +1,139 @@
+package gdatetime
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestStrptime(t *testing.T) {
+	tests := []struct {
+		value, format string
+		want          time.Time
+	}{
+		{"2024-06-03 10:15:30", "%Y-%m-%d %H:%M:%S", time.Date(2024, 6, 3, 10, 15, 30, 0, time.UTC)},
+		{"Mon, 03 Jun 2024 10:15:30 PM", "%a, %d %b %Y %I:%M:%S %p", time.Date(2024, 6, 3, 22, 15, 30, 0, time.UTC)},
+		{"June 3, 24", "%B %d, %y", time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC)},
+		{"2024-155", "%Y-%j", time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC)},
+		{"10:15:30.250", "%H:%M:%S.%f", time.Date(1900, 1, 1, 10, 15, 30, 250000000, time.UTC)},
+		{"06/03/24 10:15:30", "%x %X", time.Date(2024, 6, 3, 10, 15, 30, 0, time.UTC)},
+		{"Mon Jun 3 10:15:30 2024", "%c", time.Date(2024, 6, 3, 10, 15, 30, 0, time.UTC)},
+		{"10%", "%d%%", time.Date(1900, 1, 10, 0, 0, 0, 0, time.UTC)},
+		{"20240603", "%Y%m%d", time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC)},
+		{"20240603 101530", "%Y%m%d %H%M%S", time.Date(2024, 6, 3, 10, 15, 30, 0, time.UTC)},
+		{"20240603T101530", "%Y%m%dT%H%M%S", time.Date(2024, 6, 3, 10, 15, 30, 0, time.UTC)},
+		{"2024155", "%Y%j", time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC)},
+		{"12024-06-03", "%Y-%m-%d", time.Date(12024, 6, 3, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, test := range tests {
+		gdt, err := Strptime(test.value, test.format)
+		if err != nil {
+			t.Errorf("Strptime(%q, %q) failed: %v", test.value, test.format, err)
+			continue
+		}
+		if !gdt.t.Equal(test.want) || gdt.t.Location() != time.UTC {
+			t.Errorf("Strptime(%q, %q) = %v, want %v", test.value, test.format, gdt.t, test.want)
+		}
+	}
+}
+
+func TestStrptimeRoundTrip(t *testing.T) {
+	gdt := Create(time.Date(2024, 6, 3, 10, 15, 30, 0, time.FixedZone("", 8*3600)))
+	format := "%Y-%m-%dT%H:%M:%S%z"
+	parsed, err := Strptime(gdt.Strftime(format), format)
+	if err != nil {
+		t.Fatalf("Strptime failed: %v", err)
+	}
+	if !parsed.t.Equal(gdt.t) || parsed.Offset() != 8*3600 {
+		t.Errorf("round trip = %v, want %v", parsed.t, gdt.t)
+	}
+}
+
+func TestStrptimeZoneOffset(t *testing.T) {
+	for _, value := range []string{"10:00 +0800", "10:00 +08:00", "10:00 UTC+8", "10:00 GMT+08"} {
+		gdt, err := Strptime(value, "%H:%M %z")
+		if err != nil {
+			t.Errorf("Strptime(%q) failed: %v", value, err)
+			continue
+		}
+		if gdt.Offset() != 8*3600 || gdt.t.UTC().Hour() != 2 {
+			t.Errorf("Strptime(%q) = %v, want 10:00 +08:00", value, gdt.t)
+		}
+	}
+	gdt, err := Strptime("2024-06-03 10:00 GMT-05:30", "%Y-%m-%d %H:%M %Z")
+	if err != nil || gdt.Offset() != -(5*3600+30*60) {
+		t.Errorf("Strptime with %%Z GMT-05:30 = %v, %v", gdt, err)
+	}
+}
+
+func TestStrptimeZoneName(t *testing.T) {
+	loc := loadNewYork(t)
+	gdt, err := Strptime("2024-01-15 12:00 Eastern Standard Time", "%Y-%m-%d %H:%M %Z")
+	if err != nil {
+		t.Fatalf("Strptime with a Windows ID failed: %v", err)
+	}
+	if gdt.t.Location().String() != loc.String() || !gdt.t.Equal(time.Date(2024, 1, 15, 17, 0, 0, 0, time.UTC)) {
+		t.Errorf("Strptime with a Windows ID = %v", gdt.t)
+	}
+
+	gdt, err = Strptime("Eastern Standard Time 2024-01-15", "%Z %Y-%m-%d")
+	if err != nil || gdt.GetYear() != 2024 || gdt.t.Location().String() != loc.String() {
+		t.Errorf("Strptime with a Windows ID followed by text = %v, %v", gdt, err)
+	}
+
+	// an abbreviation keeps its offset, even when the zone uses another one at that date
+	gdt, err = Strptime("2024-07-15 12:00 EST", "%Y-%m-%d %H:%M %Z")
+	if err != nil {
+		t.Fatalf("Strptime with an abbreviation failed: %v", err)
+	}
+	if !gdt.t.Equal(time.Date(2024, 7, 15, 17, 0, 0, 0, time.UTC)) || gdt.GetHour() != 13 || gdt.ZoneName() != "EDT" {
+		t.Errorf("Strptime(12:00 EST in July) = %v, want 13:00 EDT", gdt.t)
+	}
+
+	// the second 01:30 of the end of DST is only reachable with its abbreviation
+	gdt, err = Strptime("2024-11-03 01:30 EST", "%Y-%m-%d %H:%M %Z")
+	if err != nil || !gdt.t.Equal(time.Date(2024, 11, 3, 6, 30, 0, 0, time.UTC)) {
+		t.Errorf("Strptime(01:30 EST on 2024-11-03) = %v, %v", gdt, err)
+	}
+}
+
+func TestStrptimeResolverPreference(t *testing.T) {
+	r := NewZoneResolver()
+	r.Prefer("CST", "Asia/Shanghai")
+	gdt, err := r.Strptime("2024-06-03 10:00 CST", "%Y-%m-%d %H:%M %Z")
+	if err != nil {
+		t.Skip("Asia/Shanghai not available")
+	}
+	if gdt.t.Location().String() != "Asia/Shanghai" || !gdt.t.Equal(time.Date(2024, 6, 3, 2, 0, 0, 0, time.UTC)) {
+		t.Errorf("Strptime with CST preferred as China = %v", gdt.t)
+	}
+}
+
+func TestStrptimeErrors(t *testing.T) {
+	tests := []struct {
+		value, format string
+		offset        int
+	}{
+		{"2024-13-01", "%Y-%m-%d", -1},
+		{"2024/06/03", "%Y-%m-%d", 4},
+		{"2024-06-03 junk", "%Y-%m-%d", 10},
+		{"13:00 PM", "%I:%M %p", -1},
+		{"Jux 3", "%b %d", 0},
+		{"10:00 Mars/Olympus", "%H:%M %Z", 6},
+		{"10:00", "%H:%M %q", 5},
+	}
+	for _, test := range tests {
+		_, err := Strptime(test.value, test.format)
+		var parseErr *ParseError
+		if !errors.As(err, &parseErr) || !errors.Is(err, ErrParse) {
+			t.Errorf("Strptime(%q, %q) should fail with a *ParseError, got %v", test.value, test.format, err)
+			continue
+		}
+		if parseErr.Offset != test.offset || parseErr.Layout != test.format {
+			t.Errorf("Strptime(%q, %q) error = %v, want offset %d", test.value, test.format, err, test.offset)
+		}
+	}
+	if _, err := Strptime("10:00 Nowhere", "%H:%M %Z"); !errors.Is(err, ErrUnknownZone) {
+		t.Errorf("an unknown %%Z should wrap ErrUnknownZone, got %v", err)
+	}
+}
@@ -0,0 +1,176 @@
+package gdatetime
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/linsongze/go-date-time/datetime/timeconst"
+)
+
+// WeekFields defines a week numbering: the day weeks start on and how many days of a new year, or month,
+// the first week needs. Days before the first week are in week 0 of the year, and in the last week of the previous
+// week-based year. The zero value is not valid, use a preset or WeekFieldsOf; a MinimalDays outside 1 to 7 is clamped.
+type WeekFields struct {
+	FirstDayOfWeek time.Weekday
+	MinimalDays    int
+}
+
+var (
+	// WeekFieldsISO is ISO 8601: weeks start on Monday and week 1 has the first Thursday of the year.
+	WeekFieldsISO = WeekFields{FirstDayOfWeek: time.Monday, MinimalDays: 4}
+	// WeekFieldsUS is the week of the United States: weeks start on Sunday and week 1 has January 1.
+	WeekFieldsUS = WeekFields{FirstDayOfWeek: time.Sunday, MinimalDays: 1}
+	// WeekFieldsMiddleEast is the week of most of the Middle East: weeks start on Saturday and week 1 has January 1.
+	WeekFieldsMiddleEast = WeekFields{FirstDayOfWeek: time.Saturday, MinimalDays: 1}
+	// weekFieldsSundayFull and weekFieldsMondayFull are the weeks of %U and %W, week 1 starts on the first Sunday or Monday.
+	weekFieldsSundayFull = WeekFields{FirstDayOfWeek: time.Sunday, MinimalDays: 7}
+	weekFieldsMondayFull = WeekFields{FirstDayOfWeek: time.Monday, MinimalDays: 7}
+)
+
+// The CLDR weekData, the territories not listed start on Monday with a first week of 1 day.
+var (
+	firstDayTerritories = map[time.Weekday]string{
+		time.Friday:   "MV",
+		time.Saturday: "AE AF BH DJ DZ EG IQ IR JO KW LY OM QA SD SY",
+		time.Sunday: "AG AS BD BR BS BT BW BZ CA CN CO DM DO ET GT GU HK HN ID IL IN JM JP KE KH KR LA MH MM MO MT MX MZ " +
+			"NI NP PA PE PH PK PR PT PY SA SG SV TH TT TW UM US VE VI WS YE ZA ZW",
+	}
+	minimalDays4Territories = "AD AN AT AX BE BG CH CZ DE DK EE ES FI FJ FO FR GB GF GG GI GP GR HU IE IM IS IT JE LI LT LU " +
+		"MC MQ NL NO PL PT RE RU SE SJ SK SM VA"
+)
+
+// WeekFieldsOf returns the WeekFields starting on the day with a first week of at least minimalDays days, 1 to 7.
+func WeekFieldsOf(firstDayOfWeek time.Weekday, minimalDays int) (WeekFields, error) {
+	if firstDayOfWeek < time.Sunday || firstDayOfWeek > time.Saturday {
+		return WeekFields{}, fmt.Errorf("invalid first day of week %d", int(firstDayOfWeek))
+	}
+	if minimalDays < 1 || minimalDays > 7 {
+		return WeekFields{}, fmt.Errorf("invalid minimal days %d (valid values 1 - 7)", minimalDays)
+	}
+	return WeekFields{FirstDayOfWeek: firstDayOfWeek, MinimalDays: minimalDays}, nil
+}
+
+// WeekFieldsForLocale returns the WeekFields of the territory of a locale such as "en-US", "de_DE.UTF-8" or "GB",
+// from the CLDR week data: "en-US" starts on Sunday with week 1 having January 1, "de-DE" is like ISO 8601.
+// The region is read as in BCP 47, so "de-DE-u-co-phonebk" is in Germany.
+// A locale without a known territory gets the CLDR default, Monday with a first week of 1 day.
+func WeekFieldsForLocale(locale string) WeekFields {
+	if i := strings.IndexAny(locale, ".@"); i >= 0 {
+		locale = locale[:i]
+	}
+	territory := ""
+	subtags := strings.FieldsFunc(locale, func(r rune) bool { return r == '-' || r == '_' })
+	if len(subtags) == 1 && len(locale) == 2 && locale == strings.ToUpper(locale) {
+		// a territory alone, "US", rather than a language such as "en"
+		territory = locale
+	}
+	// the region is the first 2-letter or 3-digit subtag after the language, extlang and script,
+	// and a singleton such as "u" or "x" starts the extensions, whose subtags are not regions
+	for _, subtag := range subtags[min(1, len(subtags)):] {
+		if len(subtag) == 1 {
+			break
+		}
+		if len(subtag) == 2 {
+			territory = strings.ToUpper(subtag)
+			break
+		}
+		if len(subtag) == 3 && subtag[0] >= '0' && subtag[0] <= '9' {
+			break
+		}
+	}
+	wf := WeekFields{FirstDayOfWeek: time.Monday, MinimalDays: 1}
+	if territory == "" {
+		return wf
+	}
+	for day, territories := range firstDayTerritories {
+		if slices.Contains(strings.Fields(territories), territory) {
+			wf.FirstDayOfWeek = day
+		}
+	}
+	if slices.Contains(strings.Fields(minimalDays4Territories), territory) {
+		wf.MinimalDays = 4
+	}
+	return wf
+}
+
+// String returns the WeekFields as "WeekFields[Monday,4]".
+func (wf WeekFields) String() string {
+	return fmt.Sprintf("WeekFields[%v,%d]", wf.FirstDayOfWeek, wf.MinimalDays)
+}
+
+// dayOfWeek returns the position of the weekday in the week, 0 for FirstDayOfWeek to 6.
+func (wf WeekFields) dayOfWeek(weekday time.Weekday) int {
+	return floorMod(int(weekday)-int(wf.FirstDayOfWeek), 7)
+}
+
+// weekOf returns the week of the day, 0-based from the first day of a period whose first day is at firstPosition in its week,
+// 0 for the days before the first week.
+func (wf WeekFields) weekOf(day, firstPosition int) int {
+	minimalDays := min(max(wf.MinimalDays, 1), 7)
+	week := (day + firstPosition) / 7
+	if 7-firstPosition >= minimalDays {
+		week++
+	}
+	return week
+}
+
+// weekOfWeekBasedYear returns the week-based year and week of the date.
+func (wf WeekFields) weekOfWeekBasedYear(t time.Time) (int, int) {
+	year, day := t.Year(), t.YearDay()-1
+	jan1 := wf.dayOfWeek(time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC).Weekday())
+	week := wf.weekOf(day, jan1)
+	if week == 0 {
+		// the last week of the previous year
+		previousLength := daysInYear(year - 1)
+		return year - 1, wf.weekOf(day+previousLength, floorMod(jan1-previousLength, 7))
+	}
+	length := daysInYear(year)
+	nextJan1 := floorMod(jan1+length, 7)
+	if nextJan1 != 0 && wf.weekOf(0, nextJan1) == 1 && day >= length-nextJan1 {
+		// the first week of the next year
+		return year + 1, 1
+	}
+	return year, week
+}
+
+// WeekOfYear returns the week of the year of the GDateTime with the WeekFields, 0 for the days before week 1.
+func (gdt *GDateTime) WeekOfYear(wf WeekFields) int {
+	jan1 := time.Date(gdt.t.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	return wf.weekOf(gdt.t.YearDay()-1, wf.dayOfWeek(jan1.Weekday()))
+}
+
+// WeekOfMonth returns the week of the month of the GDateTime with the WeekFields, 0 for the days before week 1.
+func (gdt *GDateTime) WeekOfMonth(wf WeekFields) int {
+	first := time.Date(gdt.t.Year(), gdt.t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return wf.weekOf(gdt.t.Day()-1, wf.dayOfWeek(first.Weekday()))
+}
+
+// WeekBasedYear returns the year the week of the GDateTime belongs to with the WeekFields,
+// which differs from the year in the days around January 1: with WeekFieldsISO 2024-12-30 is in 2025.
+func (gdt *GDateTime) WeekBasedYear(wf WeekFields) int {
+	year, _ := wf.weekOfWeekBasedYear(gdt.t)
+	return year
+}
+
+// WeekOfWeekBasedYear returns the week of the GDateTime in its WeekBasedYear with the WeekFields, from 1 to 53.
+// With WeekFieldsISO it is the ISO 8601 week.
+func (gdt *GDateTime) WeekOfWeekBasedYear(wf WeekFields) int {
+	_, week := wf.weekOfWeekBasedYear(gdt.t)
+	return week
+}
+
+// StartOfWeekWith returns the start of the day FirstDayOfWeek of the week of the GDateTime with the WeekFields.
+func (gdt *GDateTime) StartOfWeekWith(wf WeekFields) *GDateTime {
+	startOfWeek := gdt.t.AddDate(0, 0, -wf.dayOfWeek(gdt.t.Weekday()))
+	startOfWeek = time.Date(startOfWeek.Year(), startOfWeek.Month(), startOfWeek.Day(), 0, 0, 0, 0, startOfWeek.Location())
+	return Create(startOfWeek)
+}
+
+// EndOfWeekWith returns the end of the last day of the week of the GDateTime with the WeekFields.
+func (gdt *GDateTime) EndOfWeekWith(wf WeekFields) *GDateTime {
+	endOfWeek := gdt.t.AddDate(0, 0, 6-wf.dayOfWeek(gdt.t.Weekday()))
+	endOfWeek = time.Date(endOfWeek.Year(), endOfWeek.Month(), endOfWeek.Day(), 23, 59, 59, timeconst.MAX_NANO, endOfWeek.Location())
+	return Create(endOfWeek)
+}
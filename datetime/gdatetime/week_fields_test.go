@@ -0,0 +1,130 @@
+package gdatetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWeekFieldsISOMatchesISOWeek(t *testing.T) {
+	for day := time.Date(1995, 1, 1, 12, 0, 0, 0, time.UTC); day.Year() < 2035; day = day.AddDate(0, 0, 1) {
+		gdt := Create(day)
+		year, week := day.ISOWeek()
+		if gdt.WeekBasedYear(WeekFieldsISO) != year || gdt.WeekOfWeekBasedYear(WeekFieldsISO) != week {
+			t.Fatalf("%s: got %d-W%02d, want %d-W%02d", day.Format(time.DateOnly),
+				gdt.WeekBasedYear(WeekFieldsISO), gdt.WeekOfWeekBasedYear(WeekFieldsISO), year, week)
+		}
+	}
+}
+
+func TestWeekFieldsStrftimeWeeks(t *testing.T) {
+	// %U and %W count the days before the first Sunday or Monday as week 0
+	for day := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC); day.Year() < 2028; day = day.AddDate(0, 0, 1) {
+		sunday := (day.YearDay() + 6 - int(day.Weekday())) / 7
+		monday := (day.YearDay() + 6 - (int(day.Weekday())+6)%7) / 7
+		gdt := Create(day)
+		if got := gdt.WeekOfYear(weekFieldsSundayFull); got != sunday {
+			t.Fatalf("%s: %%U week = %d, want %d", day.Format(time.DateOnly), got, sunday)
+		}
+		if got := gdt.WeekOfYear(weekFieldsMondayFull); got != monday {
+			t.Fatalf("%s: %%W week = %d, want %d", day.Format(time.DateOnly), got, monday)
+		}
+	}
+	// 2027-01-01 is a Friday, in ISO week 2026-W53
+	if got := Create(time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)).Strftime("%U %W %V"); got != "00 00 53" {
+		t.Errorf("Strftime = %q, want %q", got, "00 00 53")
+	}
+}
+
+func TestWeekFieldsWeeks(t *testing.T) {
+	// 2022-01-01 is a Saturday
+	tests := []struct {
+		wf                          WeekFields
+		date                        time.Time
+		weekOfYear, weekOfMonth     int
+		weekBasedYear, weekOfWBYear int
+	}{
+		{WeekFieldsISO, time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), 0, 0, 2021, 52},
+		{WeekFieldsISO, time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC), 1, 1, 2022, 1},
+		{WeekFieldsUS, time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), 1, 1, 2022, 1},
+		{WeekFieldsUS, time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC), 2, 2, 2022, 2},
+		{WeekFieldsUS, time.Date(2022, 12, 31, 0, 0, 0, 0, time.UTC), 53, 5, 2022, 53},
+		// the week of 2024-12-29 holds January 1 2025, so it is week 1 of 2025
+		{WeekFieldsUS, time.Date(2024, 12, 29, 0, 0, 0, 0, time.UTC), 53, 5, 2025, 1},
+		{WeekFieldsMiddleEast, time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), 1, 1, 2022, 1},
+		{WeekFieldsMiddleEast, time.Date(2022, 1, 7, 0, 0, 0, 0, time.UTC), 1, 1, 2022, 1},
+		{WeekFieldsMiddleEast, time.Date(2022, 1, 8, 0, 0, 0, 0, time.UTC), 2, 2, 2022, 2},
+	}
+	for _, tt := range tests {
+		gdt := Create(tt.date)
+		if got := gdt.WeekOfYear(tt.wf); got != tt.weekOfYear {
+			t.Errorf("%v %s: WeekOfYear = %d, want %d", tt.wf, tt.date.Format(time.DateOnly), got, tt.weekOfYear)
+		}
+		if got := gdt.WeekOfMonth(tt.wf); got != tt.weekOfMonth {
+			t.Errorf("%v %s: WeekOfMonth = %d, want %d", tt.wf, tt.date.Format(time.DateOnly), got, tt.weekOfMonth)
+		}
+		if got := gdt.WeekBasedYear(tt.wf); got != tt.weekBasedYear {
+			t.Errorf("%v %s: WeekBasedYear = %d, want %d", tt.wf, tt.date.Format(time.DateOnly), got, tt.weekBasedYear)
+		}
+		if got := gdt.WeekOfWeekBasedYear(tt.wf); got != tt.weekOfWBYear {
+			t.Errorf("%v %s: WeekOfWeekBasedYear = %d, want %d", tt.wf, tt.date.Format(time.DateOnly), got, tt.weekOfWBYear)
+		}
+	}
+}
+
+func TestStartAndEndOfWeekWith(t *testing.T) {
+	// Wednesday 2024-06-12
+	gdt := Create(time.Date(2024, 6, 12, 15, 30, 0, 0, time.UTC))
+	tests := []struct {
+		wf         WeekFields
+		start, end time.Time
+	}{
+		{WeekFieldsISO, time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC), time.Date(2024, 6, 16, 23, 59, 59, 999999999, time.UTC)},
+		{WeekFieldsUS, time.Date(2024, 6, 9, 0, 0, 0, 0, time.UTC), time.Date(2024, 6, 15, 23, 59, 59, 999999999, time.UTC)},
+		{WeekFieldsMiddleEast, time.Date(2024, 6, 8, 0, 0, 0, 0, time.UTC), time.Date(2024, 6, 14, 23, 59, 59, 999999999, time.UTC)},
+	}
+	for _, tt := range tests {
+		if got := gdt.StartOfWeekWith(tt.wf); !got.t.Equal(tt.start) {
+			t.Errorf("%v: StartOfWeekWith = %v, want %v", tt.wf, got.t, tt.start)
+		}
+		if got := gdt.EndOfWeekWith(tt.wf); !got.t.Equal(tt.end) {
+			t.Errorf("%v: EndOfWeekWith = %v, want %v", tt.wf, got.t, tt.end)
+		}
+	}
+}
+
+func TestWeekFieldsOfAndLocale(t *testing.T) {
+	if _, err := WeekFieldsOf(time.Monday, 0); err == nil {
+		t.Error("WeekFieldsOf with 0 minimal days should fail")
+	}
+	if _, err := WeekFieldsOf(time.Weekday(7), 1); err == nil {
+		t.Error("WeekFieldsOf with an invalid weekday should fail")
+	}
+	if wf, err := WeekFieldsOf(time.Monday, 4); err != nil || wf != WeekFieldsISO {
+		t.Errorf("WeekFieldsOf(Monday, 4) = %v, %v, want %v", wf, err, WeekFieldsISO)
+	}
+
+	tests := map[string]WeekFields{
+		"en-US":              WeekFieldsUS,
+		"en_US.UTF-8":        WeekFieldsUS,
+		"US":                 WeekFieldsUS,
+		"de-DE":              WeekFieldsISO,
+		"en_GB":              WeekFieldsISO,
+		"ar-EG":              WeekFieldsMiddleEast,
+		"pt-PT":              {FirstDayOfWeek: time.Sunday, MinimalDays: 4},
+		"zh-Hant-TW":         WeekFieldsUS,
+		"fr":                 {FirstDayOfWeek: time.Monday, MinimalDays: 1},
+		"zh-Hant":            {FirstDayOfWeek: time.Monday, MinimalDays: 1},
+		"":                   {FirstDayOfWeek: time.Monday, MinimalDays: 1},
+		"de-DE-u-co-phonebk": WeekFieldsISO,
+		"en-GB-x-ca":         WeekFieldsISO,
+		"en-u-ca-gregory":    {FirstDayOfWeek: time.Monday, MinimalDays: 1},
+		"es-419-x-us":        {FirstDayOfWeek: time.Monday, MinimalDays: 1},
+		"sr-Latn-RS":         {FirstDayOfWeek: time.Monday, MinimalDays: 1},
+		"de-CH-1901":         WeekFieldsISO,
+	}
+	for locale, want := range tests {
+		if got := WeekFieldsForLocale(locale); got != want {
+			t.Errorf("WeekFieldsForLocale(%q) = %v, want %v", locale, got, want)
+		}
+	}
+}
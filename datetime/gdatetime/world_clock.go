@@ -0,0 +1,79 @@
+package gdatetime
+
+import (
+	"fmt"
+	"time"
+)
+
+// WorldClockRow is the time of one zone in a world clock table, see WorldClock.
+type WorldClockRow struct {
+	Zone         string         // the zone name as given to WorldClock
+	Time         *GDateTime     // the instant in the zone
+	Offset       int            // offset from UTC in seconds, such as 20700 for +05:45
+	Abbreviation string         // the zone abbreviation at the instant, such as "EDT"
+	IsDST        bool           // whether daylight saving time is in effect
+	DayOffset    int            // the local date minus the date of the reference GDateTime, such as 1 for the next day
+	Location     *time.Location // the resolved location
+}
+
+// WorldClock returns a row for each zone with the local time of the GDateTime, its offset, DST flag
+// and day offset from the date of the GDateTime in its own zone.
+// The zones are resolved with ResolveZone, so IANA names, Windows IDs, abbreviations and fixed offsets such as "UTC+8" are accepted.
+// An unknown zone returns an error matching ErrUnknownZone.
+func WorldClock(gdt *GDateTime, zones ...string) ([]WorldClockRow, error) {
+	reference := gdt.ToLocalDate()
+	rows := make([]WorldClockRow, 0, len(zones))
+	for _, zone := range zones {
+		loc, err := ResolveZone(zone)
+		if err != nil {
+			return nil, err
+		}
+		local := gdt.InLocation(loc)
+		abbreviation, offset := local.t.Zone()
+		rows = append(rows, WorldClockRow{
+			Zone:         zone,
+			Time:         local,
+			Offset:       offset,
+			Abbreviation: abbreviation,
+			IsDST:        local.t.IsDST(),
+			DayOffset:    reference.DaysUntil(local.ToLocalDate()),
+			Location:     loc,
+		})
+	}
+	return rows, nil
+}
+
+// OffsetString returns the offset of the row as "+05:45", "Z" for UTC.
+func (row WorldClockRow) OffsetString() string {
+	if row.Offset == 0 {
+		return "Z"
+	}
+	return formatOffset(row.Offset)
+}
+
+// DayOffsetString returns the day offset of the row as "+1 day", "-1 day" or "" for the same day.
+func (row WorldClockRow) DayOffsetString() string {
+	switch {
+	case row.DayOffset == 0:
+		return ""
+	case row.DayOffset == 1 || row.DayOffset == -1:
+		return fmt.Sprintf("%+d day", row.DayOffset)
+	default:
+		return fmt.Sprintf("%+d days", row.DayOffset)
+	}
+}
+
+// ZoneDifference returns how far the wall clock of b is ahead of the wall clock of a at the instant,
+// negative when b is behind, such as 5h45m from UTC to Asia/Kathmandu. Offsets are compared to the second,
+// so zones with 30 and 45 minute offsets are exact. A nil location is treated as UTC.
+func ZoneDifference(a, b *time.Location, at *GDateTime) time.Duration {
+	if a == nil {
+		a = time.UTC
+	}
+	if b == nil {
+		b = time.UTC
+	}
+	_, offsetA := at.t.In(a).Zone()
+	_, offsetB := at.t.In(b).Zone()
+	return time.Duration(offsetB-offsetA) * time.Second
+}
@@ -0,0 +1,85 @@
+package gdatetime
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWorldClock(t *testing.T) {
+	// 2024-06-03 22:30 UTC is already June 4 in Asia and still June 3 in the Americas
+	gdt := Create(time.Date(2024, 6, 3, 22, 30, 0, 0, time.UTC))
+	rows, err := WorldClock(gdt, "UTC", "America/New_York", "Asia/Kathmandu", "Australia/Adelaide", "China Standard Time")
+	if err != nil {
+		t.Skipf("zones not available: %v", err)
+	}
+	tests := []struct {
+		clock, offset, day string
+		dst                bool
+	}{
+		{"2024-06-03 22:30:00", "Z", "", false},
+		{"2024-06-03 18:30:00", "-04:00", "", true},
+		{"2024-06-04 04:15:00", "+05:45", "+1 day", false},
+		{"2024-06-04 08:00:00", "+09:30", "+1 day", false},
+		{"2024-06-04 06:30:00", "+08:00", "+1 day", false},
+	}
+	if len(rows) != len(tests) {
+		t.Fatalf("WorldClock returned %d rows, want %d", len(rows), len(tests))
+	}
+	for i, test := range tests {
+		row := rows[i]
+		if got := row.Time.ToDateTimeString(); got != test.clock || !row.Time.ToTime().Equal(gdt.ToTime()) {
+			t.Errorf("%s: time = %s, want %s", row.Zone, got, test.clock)
+		}
+		if row.OffsetString() != test.offset || row.DayOffsetString() != test.day || row.IsDST != test.dst {
+			t.Errorf("%s: offset %s, day %q, DST %v, want %s, %q, %v", row.Zone, row.OffsetString(), row.DayOffsetString(), row.IsDST, test.offset, test.day, test.dst)
+		}
+	}
+	if rows[1].Abbreviation != "EDT" || rows[2].Offset != 5*3600+45*60 {
+		t.Errorf("New York abbreviation %q and Kathmandu offset %d", rows[1].Abbreviation, rows[2].Offset)
+	}
+
+	// the day offset is relative to the date of the GDateTime in its own zone
+	shanghai, _ := gdt.InZone("Asia/Shanghai")
+	rows, _ = WorldClock(shanghai, "America/New_York")
+	if rows[0].DayOffset != -1 || rows[0].DayOffsetString() != "-1 day" {
+		t.Errorf("New York from Shanghai day offset = %d, want -1", rows[0].DayOffset)
+	}
+
+	if _, err := WorldClock(gdt, "Mars/Olympus_Mons"); !errors.Is(err, ErrUnknownZone) {
+		t.Errorf("WorldClock with an unknown zone should fail with ErrUnknownZone, got %v", err)
+	}
+}
+
+func TestZoneDifference(t *testing.T) {
+	names := []string{"Asia/Kathmandu", "Asia/Kolkata", "Pacific/Chatham", "America/St_Johns", "America/New_York"}
+	locs := map[string]*time.Location{}
+	for _, name := range names {
+		loc, err := LoadLocation(name)
+		if err != nil {
+			t.Skipf("%s not available", name)
+		}
+		locs[name] = loc
+	}
+	winter := Create(time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC))
+	summer := Create(time.Date(2024, 7, 15, 12, 0, 0, 0, time.UTC))
+	tests := []struct {
+		a, b *time.Location
+		at   *GDateTime
+		want time.Duration
+	}{
+		{nil, locs["Asia/Kathmandu"], winter, 5*time.Hour + 45*time.Minute},
+		{locs["Asia/Kolkata"], locs["Asia/Kathmandu"], winter, 15 * time.Minute},
+		{locs["Asia/Kathmandu"], locs["Asia/Kolkata"], winter, -15 * time.Minute},
+		{locs["America/St_Johns"], locs["America/New_York"], winter, -90 * time.Minute},
+		{time.UTC, locs["Pacific/Chatham"], winter, 13*time.Hour + 45*time.Minute},
+		{time.UTC, locs["Pacific/Chatham"], summer, 12*time.Hour + 45*time.Minute},
+		{locs["America/New_York"], locs["Pacific/Chatham"], summer, 16*time.Hour + 45*time.Minute},
+		{locs["America/New_York"], locs["America/New_York"], summer, 0},
+	}
+	for _, test := range tests {
+		if got := ZoneDifference(test.a, test.b, test.at); got != test.want {
+			t.Errorf("ZoneDifference(%v, %v, %s) = %v, want %v", test.a, test.b, test.at.ToDateTimeString(), got, test.want)
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package gdatetime
+
+import (
+	"errors"
+	"iter"
+	"strconv"
+	"time"
+
+	"github.com/linsongze/go-date-time/datetime/chronofield"
+	"github.com/linsongze/go-date-time/datetime/timeconst"
+)
+
+// Year is a year of the proleptic Gregorian calendar, such as a fiscal year "2024".
+// It is a value type, comparable with ==.
+type Year struct {
+	year int
+}
+
+// yearLayout describes the accepted year notation in a ParseError.
+const yearLayout = "2006"
+
+// YearOf Obtains a Year from its value
+func YearOf(year int) (Year, error) {
+	if err := checkYear(year); err != nil {
+		return Year{}, err
+	}
+	return Year{year: year}, nil
+}
+
+// ParseYear parses a year such as "2024" or "-44", a failure is returned as a *ParseError
+func ParseYear(s string) (Year, error) {
+	year, err := strconv.Atoi(s)
+	if err != nil {
+		return Year{}, &ParseError{Input: s, Layout: yearLayout, Offset: 0, Reason: "invalid year", Err: err}
+	}
+	if err := checkYear(year); err != nil {
+		return Year{}, &ParseError{Input: s, Layout: yearLayout, Offset: 0, Reason: "invalid year", Err: err}
+	}
+	return Year{year: year}, nil
+}
+
+// ToYear returns the year of the GDateTime on its own wall clock.
+func (gdt *GDateTime) ToYear() Year {
+	return Year{year: gdt.t.Year()}
+}
+
+// checkYear validates the year against the supported range.
+func checkYear(year int) error {
+	return checkField(chronofield.YEAR, year, timeconst.MIN_YEAR, timeconst.MAX_YEAR)
+}
+
+// toTime returns January 1 of the year at midnight UTC, used for formatting.
+func (y Year) toTime() time.Time {
+	return time.Date(y.year, time.January, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// GetValue returns the year as an int.
+func (y Year) GetValue() int {
+	return y.year
+}
+
+// IsLeap checks if the Year is a leap year.
+func (y Year) IsLeap() bool {
+	return isLeapYear(y.year)
+}
+
+// Length returns the number of days in the Year, 365 or 366.
+func (y Year) Length() int {
+	return daysInYear(y.year)
+}
+
+// AtDay returns the LocalDate of the day of year in the Year.
+func (y Year) AtDay(dayOfYear int) (LocalDate, error) {
+	return LocalDate{year: y.year, month: 1, day: 1}.WithDayOfYear(dayOfYear)
+}
+
+// AtMonth returns the YearMonth of the month in the Year.
+func (y Year) AtMonth(month int) (YearMonth, error) {
+	return YearMonthOf(y.year, month)
+}
+
+// AtMonthDay returns the LocalDate of the MonthDay in the Year, Feb 29 gives Feb 28 in a non-leap year.
+func (y Year) AtMonthDay(md MonthDay) LocalDate {
+	return md.AtYear(y.year)
+}
+
+// AtQuarter returns the YearQuarter of the quarter in the Year.
+func (y Year) AtQuarter(quarter int) (YearQuarter, error) {
+	return YearQuarterOf(y.year, quarter)
+}
+
+// PlusYears adds years to the Year.
+func (y Year) PlusYears(years int) Year {
+	return Year{year: y.year + years}
+}
+
+// MinusYears subtracts years from the Year.
+func (y Year) MinusYears(years int) Year {
+	return y.PlusYears(-years)
+}
+
+// Until iterates over the years from this Year up to, but not including, the end Year.
+func (y Year) Until(end Year) iter.Seq[Year] {
+	return func(yield func(Year) bool) {
+		for current := y.year; current < end.year; current++ {
+			if !yield(Year{year: current}) {
+				return
+			}
+		}
+	}
+}
+
+// Months iterates over the twelve months of the Year.
+func (y Year) Months() iter.Seq[YearMonth] {
+	return YearMonth{year: y.year, month: 1}.Until(YearMonth{year: y.year + 1, month: 1})
+}
+
+// Quarters iterates over the four quarters of the Year.
+func (y Year) Quarters() iter.Seq[YearQuarter] {
+	return YearQuarter{year: y.year, quarter: 1}.Until(YearQuarter{year: y.year + 1, quarter: 1})
+}
+
+// Days iterates over the days of the Year.
+func (y Year) Days() iter.Seq[LocalDate] {
+	return daysFrom(LocalDate{year: y.year, month: 1, day: 1}, y.Length())
+}
+
+// CompareTo compares this Year with another, returning -1, 0 or 1.
+func (y Year) CompareTo(other Year) int {
+	return compareInt(y.year, other.year)
+}
+
+// IsBefore checks if this Year is before the other.
+func (y Year) IsBefore(other Year) bool {
+	return y.year < other.year
+}
+
+// IsAfter checks if this Year is after the other.
+func (y Year) IsAfter(other Year) bool {
+	return y.year > other.year
+}
+
+// IsEqual checks if this Year is the same year as the other.
+func (y Year) IsEqual(other Year) bool {
+	return y == other
+}
+
+// ToFormatString formats the Year based on time package layout specifier, month and day elements give January 1.
+func (y Year) ToFormatString(layout string) string {
+	return y.toTime().Format(layout)
+}
+
+// Strftime formats the Year with a C style format such as "FY%Y", month and day elements give January 1.
+func (y Year) Strftime(f string) string {
+	t := y.toTime()
+	return strftime(&t, f, false)
+}
+
+// String returns the Year as a decimal number such as "2024".
+func (y Year) String() string {
+	return strconv.Itoa(y.year)
+}
+
+// MarshalText implements encoding.TextMarshaler using the decimal year.
+func (y Year) MarshalText() ([]byte, error) {
+	return []byte(y.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the decimal year.
+func (y *Year) UnmarshalText(text []byte) error {
+	if y == nil {
+		return errors.New("UnmarshalText on nil pointer")
+	}
+	parsed, err := ParseYear(string(text))
+	if err != nil {
+		return err
+	}
+	*y = parsed
+	return nil
+}
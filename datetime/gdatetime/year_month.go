@@ -0,0 +1,217 @@
+package gdatetime
+
+import (
+	"errors"
+	"iter"
+	"time"
+
+	"github.com/linsongze/go-date-time/datetime/chronofield"
+)
+
+// YearMonth is a month of a year without a day, such as a billing month "2024-06".
+// It is a value type, comparable with ==.
+type YearMonth struct {
+	year  int
+	month int
+}
+
+// yearMonthLayout is the ISO 8601 layout used by String and ParseYearMonth.
+const yearMonthLayout = "2006-01"
+
+// YearMonthOf Obtains a YearMonth from year and month
+func YearMonthOf(year, month int) (YearMonth, error) {
+	if err := checkDateFields(year, month, 1); err != nil {
+		return YearMonth{}, err
+	}
+	return YearMonth{year: year, month: month}, nil
+}
+
+// ParseYearMonth parses an ISO 8601 year-month such as "2024-06" or "-0005-01", a failure is returned as a *ParseError
+func ParseYearMonth(s string) (YearMonth, error) {
+	year, t, err := parseYearPrefixed(s, yearMonthLayout)
+	if err != nil {
+		return YearMonth{}, err
+	}
+	return YearMonth{year: year, month: int(t.Month())}, nil
+}
+
+// ToYearMonth returns the year and month of the GDateTime on its own wall clock.
+func (gdt *GDateTime) ToYearMonth() YearMonth {
+	return YearMonth{year: gdt.t.Year(), month: int(gdt.t.Month())}
+}
+
+// toTime returns the first day of the month at midnight UTC, used for formatting.
+func (ym YearMonth) toTime() time.Time {
+	return time.Date(ym.year, time.Month(ym.month), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// GetYear returns the year of the YearMonth.
+func (ym YearMonth) GetYear() int {
+	return ym.year
+}
+
+// GetMonth returns the month of the YearMonth as an integer (1-12).
+func (ym YearMonth) GetMonth() int {
+	return ym.month
+}
+
+// GetQuarter returns the YearQuarter containing the YearMonth.
+func (ym YearMonth) GetQuarter() YearQuarter {
+	return YearQuarter{year: ym.year, quarter: (ym.month-1)/3 + 1}
+}
+
+// LengthOfMonth returns the number of days in the month.
+func (ym YearMonth) LengthOfMonth() int {
+	return DaysInMonth(ym.year, ym.month)
+}
+
+// LengthOfYear returns the number of days in the year, 365 or 366.
+func (ym YearMonth) LengthOfYear() int {
+	return daysInYear(ym.year)
+}
+
+// IsLeap checks if the year of the YearMonth is a leap year.
+func (ym YearMonth) IsLeap() bool {
+	return isLeapYear(ym.year)
+}
+
+// IsValidDay checks if the day of month exists in the YearMonth.
+func (ym YearMonth) IsValidDay(day int) bool {
+	return day >= 1 && day <= ym.LengthOfMonth()
+}
+
+// AtDay returns the LocalDate of the day of month in the YearMonth.
+func (ym YearMonth) AtDay(day int) (LocalDate, error) {
+	if err := checkField(chronofield.DAY_OF_MONTH, day, 1, ym.LengthOfMonth()); err != nil {
+		return LocalDate{}, err
+	}
+	return LocalDate{year: ym.year, month: ym.month, day: day}, nil
+}
+
+// AtEndOfMonth returns the LocalDate of the last day of the YearMonth.
+func (ym YearMonth) AtEndOfMonth() LocalDate {
+	return LocalDate{year: ym.year, month: ym.month, day: ym.LengthOfMonth()}
+}
+
+// WithYear Returns a copy of this YearMonth with the year altered.
+func (ym YearMonth) WithYear(year int) (YearMonth, error) {
+	return YearMonthOf(year, ym.month)
+}
+
+// WithMonth Returns a copy of this YearMonth with the month altered.
+func (ym YearMonth) WithMonth(month int) (YearMonth, error) {
+	return YearMonthOf(ym.year, month)
+}
+
+// PlusYears adds years to the YearMonth.
+func (ym YearMonth) PlusYears(years int) YearMonth {
+	return YearMonth{year: ym.year + years, month: ym.month}
+}
+
+// PlusMonths adds months to the YearMonth, rolling over into the next or previous year.
+func (ym YearMonth) PlusMonths(months int) YearMonth {
+	total := ym.prolepticMonth() + months
+	return YearMonth{year: floorDiv(total, 12), month: floorMod(total, 12) + 1}
+}
+
+// MinusYears subtracts years from the YearMonth.
+func (ym YearMonth) MinusYears(years int) YearMonth {
+	return ym.PlusYears(-years)
+}
+
+// MinusMonths subtracts months from the YearMonth.
+func (ym YearMonth) MinusMonths(months int) YearMonth {
+	return ym.PlusMonths(-months)
+}
+
+// MonthsUntil returns the number of months from this YearMonth to the end YearMonth, negative if end is before.
+func (ym YearMonth) MonthsUntil(end YearMonth) int {
+	return end.prolepticMonth() - ym.prolepticMonth()
+}
+
+// prolepticMonth returns the number of months since year 0, like chronofield.PROLEPTIC_MONTH.
+func (ym YearMonth) prolepticMonth() int {
+	return ym.year*12 + ym.month - 1
+}
+
+// Until iterates over the months from this YearMonth up to, but not including, the end YearMonth.
+func (ym YearMonth) Until(end YearMonth) iter.Seq[YearMonth] {
+	return func(yield func(YearMonth) bool) {
+		for current := ym; current.IsBefore(end); current = current.PlusMonths(1) {
+			if !yield(current) {
+				return
+			}
+		}
+	}
+}
+
+// Days iterates over the days of the YearMonth.
+func (ym YearMonth) Days() iter.Seq[LocalDate] {
+	return daysFrom(LocalDate{year: ym.year, month: ym.month, day: 1}, ym.LengthOfMonth())
+}
+
+// CompareTo compares this YearMonth with another, returning -1, 0 or 1.
+func (ym YearMonth) CompareTo(other YearMonth) int {
+	return compareInt(ym.prolepticMonth(), other.prolepticMonth())
+}
+
+// IsBefore checks if this YearMonth is before the other.
+func (ym YearMonth) IsBefore(other YearMonth) bool {
+	return ym.CompareTo(other) < 0
+}
+
+// IsAfter checks if this YearMonth is after the other.
+func (ym YearMonth) IsAfter(other YearMonth) bool {
+	return ym.CompareTo(other) > 0
+}
+
+// IsEqual checks if this YearMonth is the same month as the other.
+func (ym YearMonth) IsEqual(other YearMonth) bool {
+	return ym == other
+}
+
+// ToFormatString formats the YearMonth based on time package layout specifier, day elements give the first day.
+func (ym YearMonth) ToFormatString(layout string) string {
+	return ym.toTime().Format(layout)
+}
+
+// Strftime formats the YearMonth with a C style format such as "%B %Y", day elements give the first day.
+func (ym YearMonth) Strftime(f string) string {
+	t := ym.toTime()
+	return strftime(&t, f, false)
+}
+
+// String returns the YearMonth in ISO 8601 format yyyy-MM.
+func (ym YearMonth) String() string {
+	return ym.ToFormatString(yearMonthLayout)
+}
+
+// MarshalText implements encoding.TextMarshaler using the ISO 8601 format.
+func (ym YearMonth) MarshalText() ([]byte, error) {
+	return []byte(ym.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the ISO 8601 format.
+func (ym *YearMonth) UnmarshalText(text []byte) error {
+	if ym == nil {
+		return errors.New("UnmarshalText on nil pointer")
+	}
+	parsed, err := ParseYearMonth(string(text))
+	if err != nil {
+		return err
+	}
+	*ym = parsed
+	return nil
+}
+
+// daysFrom iterates over count consecutive days starting at start.
+func daysFrom(start LocalDate, count int) iter.Seq[LocalDate] {
+	return func(yield func(LocalDate) bool) {
+		first := start.EpochDay()
+		for i := 0; i < count; i++ {
+			if !yield(LocalDateOfEpochDay(first + int64(i))) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package gdatetime
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestYearMonthOf(t *testing.T) {
+	ym := must(YearMonthOf(2024, 2))
+	if ym.GetYear() != 2024 || ym.GetMonth() != 2 || ym.LengthOfMonth() != 29 || ym.LengthOfYear() != 366 || !ym.IsLeap() {
+		t.Errorf("YearMonth getters failed for %v", ym)
+	}
+	if ym.GetQuarter() != (YearQuarter{2024, 1}) {
+		t.Errorf("GetQuarter failed, got %v", ym.GetQuarter())
+	}
+	if _, err := YearMonthOf(2024, 13); !errors.Is(err, ErrFieldRange) {
+		t.Errorf("YearMonthOf(2024, 13) should fail with ErrFieldRange, got %v", err)
+	}
+	if got := Create(time.Date(2024, 6, 15, 10, 0, 0, 0, time.UTC)).ToYearMonth(); got != must(YearMonthOf(2024, 6)) {
+		t.Errorf("ToYearMonth failed, got %v", got)
+	}
+}
+
+func TestYearMonthAtDay(t *testing.T) {
+	ym := must(YearMonthOf(2023, 2))
+	if got, err := ym.AtDay(28); err != nil || got != must(LocalDateOf(2023, 2, 28)) {
+		t.Errorf("AtDay(28) failed, got %v, %v", got, err)
+	}
+	if _, err := ym.AtDay(29); err == nil || ym.IsValidDay(29) {
+		t.Error("AtDay(29) should fail in February 2023")
+	}
+	if got := ym.AtEndOfMonth(); got != must(LocalDateOf(2023, 2, 28)) {
+		t.Errorf("AtEndOfMonth failed, got %v", got)
+	}
+}
+
+func TestYearMonthPlus(t *testing.T) {
+	ym := must(YearMonthOf(2024, 11))
+	if got := ym.PlusMonths(3); got != must(YearMonthOf(2025, 2)) {
+		t.Errorf("PlusMonths failed, got %v", got)
+	}
+	if got := ym.MinusMonths(11); got != must(YearMonthOf(2023, 12)) {
+		t.Errorf("MinusMonths failed, got %v", got)
+	}
+	if got := ym.PlusYears(1).MinusYears(2); got != must(YearMonthOf(2023, 11)) {
+		t.Errorf("PlusYears/MinusYears failed, got %v", got)
+	}
+	if ym.MonthsUntil(must(YearMonthOf(2025, 2))) != 3 {
+		t.Errorf("MonthsUntil failed")
+	}
+}
+
+func TestYearMonthIteration(t *testing.T) {
+	months := slices.Collect(must(YearMonthOf(2024, 11)).Until(must(YearMonthOf(2025, 2))))
+	expected := []YearMonth{must(YearMonthOf(2024, 11)), must(YearMonthOf(2024, 12)), must(YearMonthOf(2025, 1))}
+	if !slices.Equal(months, expected) {
+		t.Errorf("Until failed, got %v", months)
+	}
+
+	days := slices.Collect(must(YearMonthOf(2024, 2)).Days())
+	if len(days) != 29 || days[0] != must(LocalDateOf(2024, 2, 1)) || days[28] != must(LocalDateOf(2024, 2, 29)) {
+		t.Errorf("Days failed, got %v", days)
+	}
+	for d := range must(YearMonthOf(2024, 2)).Days() {
+		if d.GetDayOfMonth() == 2 {
+			break
+		}
+	}
+}
+
+func TestYearMonthFormatAndParse(t *testing.T) {
+	ym := must(YearMonthOf(2024, 6))
+	if ym.String() != "2024-06" || ym.Strftime("%B %Y") != "June 2024" || ym.ToFormatString("Jan 2006") != "Jun 2024" {
+		t.Errorf("formatting failed for %v", ym)
+	}
+	if !ym.IsBefore(must(YearMonthOf(2024, 7))) || !ym.IsAfter(must(YearMonthOf(2023, 12))) || !ym.IsEqual(ym) {
+		t.Errorf("comparison failed for %v", ym)
+	}
+	if got, err := ParseYearMonth("2024-06"); err != nil || got != ym {
+		t.Errorf("ParseYearMonth failed, got %v, %v", got, err)
+	}
+	if _, err := ParseYearMonth("2024-13"); !errors.Is(err, ErrParse) {
+		t.Errorf("ParseYearMonth(2024-13) should fail with ErrParse, got %v", err)
+	}
+
+	data, _ := json.Marshal(map[string]YearMonth{"billing": ym})
+	if string(data) != `{"billing":"2024-06"}` {
+		t.Errorf("json.Marshal failed, got %s", data)
+	}
+	var decoded map[string]YearMonth
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded["billing"] != ym {
+		t.Errorf("json.Unmarshal failed, got %v, %v", decoded, err)
+	}
+}
+
+func TestYearMonthTextRoundTripBeyondFourDigits(t *testing.T) {
+	for _, ym := range []YearMonth{must(YearMonthOf(12345, 1)), must(YearMonthOf(-5, 12))} {
+		text, _ := ym.MarshalText()
+		var got YearMonth
+		if err := got.UnmarshalText(text); err != nil || got != ym {
+			t.Errorf("YearMonth %s does not round trip, got %v, %v", text, got, err)
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package gdatetime
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/linsongze/go-date-time/datetime/chronofield"
+)
+
+// YearQuarter is a quarter of a year, such as a reporting period "2024-Q2".
+// It is a value type, comparable with ==.
+type YearQuarter struct {
+	year    int
+	quarter int
+}
+
+// yearQuarterLayout describes the accepted year-quarter notation in a ParseError.
+const yearQuarterLayout = "yyyy-Qn"
+
+var yearQuarterPattern = regexp.MustCompile(`^([-+]?[0-9]{4,})-[Qq]([0-9]+)$`)
+
+// YearQuarterOf Obtains a YearQuarter from year and quarter (1-4)
+func YearQuarterOf(year, quarter int) (YearQuarter, error) {
+	if err := checkYear(year); err != nil {
+		return YearQuarter{}, err
+	}
+	if err := checkField(chronofield.QUARTER_OF_YEAR, quarter, 1, 4); err != nil {
+		return YearQuarter{}, err
+	}
+	return YearQuarter{year: year, quarter: quarter}, nil
+}
+
+// ParseYearQuarter parses a year-quarter such as "2024-Q2", a failure is returned as a *ParseError
+func ParseYearQuarter(s string) (YearQuarter, error) {
+	match := yearQuarterPattern.FindStringSubmatch(s)
+	if match == nil {
+		return YearQuarter{}, &ParseError{Input: s, Layout: yearQuarterLayout, Offset: -1, Reason: "invalid year-quarter"}
+	}
+	year, err := strconv.Atoi(match[1])
+	if err != nil {
+		return YearQuarter{}, &ParseError{Input: s, Layout: yearQuarterLayout, Offset: 0, Reason: "invalid year", Err: err}
+	}
+	quarter, _ := strconv.Atoi(match[2])
+	yq, err := YearQuarterOf(year, quarter)
+	if err != nil {
+		return YearQuarter{}, &ParseError{Input: s, Layout: yearQuarterLayout, Offset: -1, Reason: "invalid year-quarter", Err: err}
+	}
+	return yq, nil
+}
+
+// ToYearQuarter returns the year and quarter of the GDateTime on its own wall clock.
+func (gdt *GDateTime) ToYearQuarter() YearQuarter {
+	return YearQuarter{year: gdt.t.Year(), quarter: (int(gdt.t.Month())-1)/3 + 1}
+}
+
+// toTime returns the first day of the quarter at midnight UTC, used for formatting.
+func (yq YearQuarter) toTime() time.Time {
+	return time.Date(yq.year, time.Month(yq.FirstMonth().month), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// GetYear returns the year of the YearQuarter.
+func (yq YearQuarter) GetYear() int {
+	return yq.year
+}
+
+// GetQuarter returns the quarter of the YearQuarter (1-4).
+func (yq YearQuarter) GetQuarter() int {
+	return yq.quarter
+}
+
+// IsLeap checks if the year of the YearQuarter is a leap year.
+func (yq YearQuarter) IsLeap() bool {
+	return isLeapYear(yq.year)
+}
+
+// LengthOfQuarter returns the number of days in the quarter, 90 to 92.
+func (yq YearQuarter) LengthOfQuarter() int {
+	first := yq.FirstMonth().month
+	return DaysInMonth(yq.year, first) + DaysInMonth(yq.year, first+1) + DaysInMonth(yq.year, first+2)
+}
+
+// FirstMonth returns the first YearMonth of the quarter.
+func (yq YearQuarter) FirstMonth() YearMonth {
+	return YearMonth{year: yq.year, month: (yq.quarter-1)*3 + 1}
+}
+
+// LastMonth returns the last YearMonth of the quarter.
+func (yq YearQuarter) LastMonth() YearMonth {
+	return YearMonth{year: yq.year, month: yq.quarter * 3}
+}
+
+// AtDay returns the LocalDate of the day of the quarter (1 to LengthOfQuarter).
+func (yq YearQuarter) AtDay(dayOfQuarter int) (LocalDate, error) {
+	if err := checkField(chronofield.DAY_OF_QUARTER, dayOfQuarter, 1, yq.LengthOfQuarter()); err != nil {
+		return LocalDate{}, err
+	}
+	first := yq.FirstMonth()
+	return LocalDate{year: first.year, month: first.month, day: 1}.PlusDays(dayOfQuarter - 1), nil
+}
+
+// AtEndOfQuarter returns the LocalDate of the last day of the quarter.
+func (yq YearQuarter) AtEndOfQuarter() LocalDate {
+	return yq.LastMonth().AtEndOfMonth()
+}
+
+// PlusYears adds years to the YearQuarter.
+func (yq YearQuarter) PlusYears(years int) YearQuarter {
+	return YearQuarter{year: yq.year + years, quarter: yq.quarter}
+}
+
+// PlusQuarters adds quarters to the YearQuarter, rolling over into the next or previous year.
+func (yq YearQuarter) PlusQuarters(quarters int) YearQuarter {
+	total := yq.prolepticQuarter() + quarters
+	return YearQuarter{year: floorDiv(total, 4), quarter: floorMod(total, 4) + 1}
+}
+
+// MinusYears subtracts years from the YearQuarter.
+func (yq YearQuarter) MinusYears(years int) YearQuarter {
+	return yq.PlusYears(-years)
+}
+
+// MinusQuarters subtracts quarters from the YearQuarter.
+func (yq YearQuarter) MinusQuarters(quarters int) YearQuarter {
+	return yq.PlusQuarters(-quarters)
+}
+
+// QuartersUntil returns the number of quarters from this YearQuarter to the end YearQuarter, negative if end is before.
+func (yq YearQuarter) QuartersUntil(end YearQuarter) int {
+	return end.prolepticQuarter() - yq.prolepticQuarter()
+}
+
+// prolepticQuarter returns the number of quarters since year 0.
+func (yq YearQuarter) prolepticQuarter() int {
+	return yq.year*4 + yq.quarter - 1
+}
+
+// Until iterates over the quarters from this YearQuarter up to, but not including, the end YearQuarter.
+func (yq YearQuarter) Until(end YearQuarter) iter.Seq[YearQuarter] {
+	return func(yield func(YearQuarter) bool) {
+		for current := yq; current.IsBefore(end); current = current.PlusQuarters(1) {
+			if !yield(current) {
+				return
+			}
+		}
+	}
+}
+
+// Months iterates over the three months of the quarter.
+func (yq YearQuarter) Months() iter.Seq[YearMonth] {
+	return yq.FirstMonth().Until(yq.LastMonth().PlusMonths(1))
+}
+
+// Days iterates over the days of the quarter.
+func (yq YearQuarter) Days() iter.Seq[LocalDate] {
+	first := yq.FirstMonth()
+	return daysFrom(LocalDate{year: first.year, month: first.month, day: 1}, yq.LengthOfQuarter())
+}
+
+// CompareTo compares this YearQuarter with another, returning -1, 0 or 1.
+func (yq YearQuarter) CompareTo(other YearQuarter) int {
+	return compareInt(yq.prolepticQuarter(), other.prolepticQuarter())
+}
+
+// IsBefore checks if this YearQuarter is before the other.
+func (yq YearQuarter) IsBefore(other YearQuarter) bool {
+	return yq.CompareTo(other) < 0
+}
+
+// IsAfter checks if this YearQuarter is after the other.
+func (yq YearQuarter) IsAfter(other YearQuarter) bool {
+	return yq.CompareTo(other) > 0
+}
+
+// IsEqual checks if this YearQuarter is the same quarter as the other.
+func (yq YearQuarter) IsEqual(other YearQuarter) bool {
+	return yq == other
+}
+
+// ToFormatString formats the YearQuarter based on time package layout specifier, month and day elements give the first day.
+func (yq YearQuarter) ToFormatString(layout string) string {
+	return yq.toTime().Format(layout)
+}
+
+// Strftime formats the YearQuarter with a C style format, month and day elements give the first day of the quarter.
+func (yq YearQuarter) Strftime(f string) string {
+	t := yq.toTime()
+	return strftime(&t, f, false)
+}
+
+// String returns the YearQuarter as yyyy-Qn, such as "2024-Q2".
+func (yq YearQuarter) String() string {
+	return fmt.Sprintf("%s-Q%d", yq.toTime().Format("2006"), yq.quarter)
+}
+
+// MarshalText implements encoding.TextMarshaler using the yyyy-Qn format.
+func (yq YearQuarter) MarshalText() ([]byte, error) {
+	return []byte(yq.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the yyyy-Qn format.
+func (yq *YearQuarter) UnmarshalText(text []byte) error {
+	if yq == nil {
+		return errors.New("UnmarshalText on nil pointer")
+	}
+	parsed, err := ParseYearQuarter(string(text))
+	if err != nil {
+		return err
+	}
+	*yq = parsed
+	return nil
+}
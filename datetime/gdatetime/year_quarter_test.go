@@ -0,0 +1,95 @@
+package gdatetime
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestYearQuarterOf(t *testing.T) {
+	yq := must(YearQuarterOf(2024, 1))
+	if yq.GetYear() != 2024 || yq.GetQuarter() != 1 || !yq.IsLeap() || yq.LengthOfQuarter() != 91 {
+		t.Errorf("YearQuarter getters failed for %v", yq)
+	}
+	if must(YearQuarterOf(2023, 1)).LengthOfQuarter() != 90 || must(YearQuarterOf(2023, 3)).LengthOfQuarter() != 92 {
+		t.Errorf("LengthOfQuarter failed")
+	}
+	if yq.FirstMonth() != must(YearMonthOf(2024, 1)) || yq.LastMonth() != must(YearMonthOf(2024, 3)) {
+		t.Errorf("FirstMonth/LastMonth failed for %v", yq)
+	}
+	if _, err := YearQuarterOf(2024, 5); !errors.Is(err, ErrFieldRange) {
+		t.Errorf("YearQuarterOf(2024, 5) should fail with ErrFieldRange, got %v", err)
+	}
+	if got := Create(time.Date(2024, 8, 15, 0, 0, 0, 0, time.UTC)).ToYearQuarter(); got != must(YearQuarterOf(2024, 3)) {
+		t.Errorf("ToYearQuarter failed, got %v", got)
+	}
+}
+
+func TestYearQuarterAtDayAndPlus(t *testing.T) {
+	yq := must(YearQuarterOf(2024, 2))
+	if got, err := yq.AtDay(31); err != nil || got != must(LocalDateOf(2024, 5, 1)) {
+		t.Errorf("AtDay(31) failed, got %v, %v", got, err)
+	}
+	if _, err := yq.AtDay(92); err == nil {
+		t.Error("AtDay(92) should fail in Q2")
+	}
+	if got := yq.AtEndOfQuarter(); got != must(LocalDateOf(2024, 6, 30)) {
+		t.Errorf("AtEndOfQuarter failed, got %v", got)
+	}
+	if got := yq.PlusQuarters(3); got != must(YearQuarterOf(2025, 1)) {
+		t.Errorf("PlusQuarters failed, got %v", got)
+	}
+	if got := yq.MinusQuarters(2); got != must(YearQuarterOf(2023, 4)) {
+		t.Errorf("MinusQuarters failed, got %v", got)
+	}
+	if got := yq.PlusYears(1).MinusYears(3); got != must(YearQuarterOf(2022, 2)) {
+		t.Errorf("PlusYears/MinusYears failed, got %v", got)
+	}
+	if yq.QuartersUntil(must(YearQuarterOf(2025, 1))) != 3 {
+		t.Errorf("QuartersUntil failed")
+	}
+}
+
+func TestYearQuarterIteration(t *testing.T) {
+	yq := must(YearQuarterOf(2024, 4))
+	quarters := slices.Collect(yq.Until(must(YearQuarterOf(2025, 3))))
+	expected := []YearQuarter{must(YearQuarterOf(2024, 4)), must(YearQuarterOf(2025, 1)), must(YearQuarterOf(2025, 2))}
+	if !slices.Equal(quarters, expected) {
+		t.Errorf("Until failed, got %v", quarters)
+	}
+	months := slices.Collect(yq.Months())
+	if !slices.Equal(months, []YearMonth{must(YearMonthOf(2024, 10)), must(YearMonthOf(2024, 11)), must(YearMonthOf(2024, 12))}) {
+		t.Errorf("Months failed, got %v", months)
+	}
+	if days := slices.Collect(yq.Days()); len(days) != 92 || days[91] != must(LocalDateOf(2024, 12, 31)) {
+		t.Errorf("Days failed, got %d days", len(days))
+	}
+}
+
+func TestYearQuarterFormatAndParse(t *testing.T) {
+	yq := must(YearQuarterOf(2024, 2))
+	if yq.String() != "2024-Q2" || yq.Strftime("%Y-%m-%d") != "2024-04-01" {
+		t.Errorf("formatting failed for %v", yq)
+	}
+	if !yq.IsBefore(must(YearQuarterOf(2024, 3))) || !yq.IsAfter(must(YearQuarterOf(2023, 4))) || !yq.IsEqual(yq) {
+		t.Errorf("comparison failed for %v", yq)
+	}
+	for _, input := range []string{"2024-Q2", "2024-q2"} {
+		if got, err := ParseYearQuarter(input); err != nil || got != yq {
+			t.Errorf("ParseYearQuarter(%s) failed, got %v, %v", input, got, err)
+		}
+	}
+	for _, input := range []string{"2024-Q5", "2024Q2", "24-Q2"} {
+		if _, err := ParseYearQuarter(input); !errors.Is(err, ErrParse) {
+			t.Errorf("ParseYearQuarter(%s) should fail with ErrParse, got %v", input, err)
+		}
+	}
+
+	data, _ := json.Marshal(yq)
+	var decoded YearQuarter
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded != yq || string(data) != `"2024-Q2"` {
+		t.Errorf("JSON round trip failed, got %s, %v, %v", data, decoded, err)
+	}
+}
@@ -0,0 +1,86 @@
+package gdatetime
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestYearOf(t *testing.T) {
+	y, err := YearOf(2024)
+	if err != nil {
+		t.Fatalf("YearOf returned error: %v", err)
+	}
+	if y.GetValue() != 2024 || !y.IsLeap() || y.Length() != 366 {
+		t.Errorf("Year getters failed for %v", y)
+	}
+	if _, err := YearOf(1_000_000_000); !errors.Is(err, ErrFieldRange) {
+		t.Errorf("YearOf out of range should fail with ErrFieldRange, got %v", err)
+	}
+}
+
+func TestYearAt(t *testing.T) {
+	y, _ := YearOf(2023)
+	if got, err := y.AtDay(365); err != nil || got != must(LocalDateOf(2023, 12, 31)) {
+		t.Errorf("AtDay(365) failed, got %v, %v", got, err)
+	}
+	if _, err := y.AtDay(366); err == nil {
+		t.Error("AtDay(366) should fail in 2023")
+	}
+	if got, _ := y.AtMonth(6); got != must(YearMonthOf(2023, 6)) {
+		t.Errorf("AtMonth failed, got %v", got)
+	}
+	if got := y.AtMonthDay(must(MonthDayOf(2, 29))); got != must(LocalDateOf(2023, 2, 28)) {
+		t.Errorf("AtMonthDay failed, got %v", got)
+	}
+	if got, _ := y.AtQuarter(3); got != (YearQuarter{2023, 3}) {
+		t.Errorf("AtQuarter failed, got %v", got)
+	}
+}
+
+func TestYearIteration(t *testing.T) {
+	y, _ := YearOf(2024)
+	if months := slices.Collect(y.Months()); len(months) != 12 || months[11] != must(YearMonthOf(2024, 12)) {
+		t.Errorf("Months failed, got %v", months)
+	}
+	if quarters := slices.Collect(y.Quarters()); len(quarters) != 4 || quarters[0] != (YearQuarter{2024, 1}) {
+		t.Errorf("Quarters failed, got %v", quarters)
+	}
+	if days := slices.Collect(y.Days()); len(days) != 366 {
+		t.Errorf("Days failed, got %d days", len(days))
+	}
+	years := slices.Collect(y.Until(y.PlusYears(3)))
+	if len(years) != 3 || years[2] != y.PlusYears(2) || !years[0].IsBefore(years[1]) || years[1].CompareTo(years[0]) != 1 {
+		t.Errorf("Until failed, got %v", years)
+	}
+}
+
+func TestYearFormatAndParse(t *testing.T) {
+	y, _ := YearOf(2024)
+	if y.String() != "2024" || y.Strftime("FY%Y") != "FY2024" || y.MinusYears(1).ToFormatString("06") != "23" {
+		t.Errorf("formatting failed for %v", y)
+	}
+	if got, err := ParseYear("-44"); err != nil || got.GetValue() != -44 {
+		t.Errorf("ParseYear(-44) failed, got %v, %v", got, err)
+	}
+	if _, err := ParseYear("20x4"); !errors.Is(err, ErrParse) {
+		t.Errorf("ParseYear(20x4) should fail with ErrParse, got %v", err)
+	}
+
+	data, _ := json.Marshal(y)
+	var decoded Year
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded != y || string(data) != `"2024"` {
+		t.Errorf("JSON round trip failed, got %s, %v, %v", data, decoded, err)
+	}
+}
+
+func TestYearTextRoundTripBeyondFourDigits(t *testing.T) {
+	for _, y := range []Year{must(YearOf(12345)), must(YearOf(-5))} {
+		text, _ := y.MarshalText()
+		var got Year
+		if err := got.UnmarshalText(text); err != nil || got != y {
+			t.Errorf("Year %s does not round trip, got %v, %v", text, got, err)
+		}
+	}
+}
@@ -0,0 +1,246 @@
+package gdatetime
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/linsongze/go-date-time/datetime/chronofield"
+)
+
+// YearWeek is an ISO 8601 week of a week-based year, such as "2025-W01", which starts on Monday 2024-12-30.
+// It is a value type, comparable with ==.
+type YearWeek struct {
+	year int
+	week int
+}
+
+// yearWeekLayout describes the accepted year-week notation in a ParseError.
+const yearWeekLayout = "yyyy-Www"
+
+var yearWeekPattern = regexp.MustCompile(`^([-+]?[0-9]{4,})-?[Ww]([0-9]{2})$`)
+
+// ISOWeeksInYear returns the number of ISO 8601 weeks, 52 or 53, in the week-based year.
+func ISOWeeksInYear(year int) int {
+	_, week := time.Date(year, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+	return week
+}
+
+// YearWeekOf Obtains a YearWeek from a week-based year and week (1 to ISOWeeksInYear)
+func YearWeekOf(year, week int) (YearWeek, error) {
+	if err := checkYear(year); err != nil {
+		return YearWeek{}, err
+	}
+	if err := checkField(chronofield.ISO_WEEK, week, 1, ISOWeeksInYear(year)); err != nil {
+		return YearWeek{}, err
+	}
+	return YearWeek{year: year, week: week}, nil
+}
+
+// ParseYearWeek parses a year-week such as "2025-W01" or "2025W01", a failure is returned as a *ParseError
+func ParseYearWeek(s string) (YearWeek, error) {
+	match := yearWeekPattern.FindStringSubmatch(s)
+	if match == nil {
+		return YearWeek{}, &ParseError{Input: s, Layout: yearWeekLayout, Offset: -1, Reason: "invalid year-week"}
+	}
+	year, err := strconv.Atoi(match[1])
+	if err != nil {
+		return YearWeek{}, &ParseError{Input: s, Layout: yearWeekLayout, Offset: 0, Reason: "invalid year", Err: err}
+	}
+	week, _ := strconv.Atoi(match[2])
+	yw, err := YearWeekOf(year, week)
+	if err != nil {
+		return YearWeek{}, &ParseError{Input: s, Layout: yearWeekLayout, Offset: -1, Reason: "invalid year-week", Err: err}
+	}
+	return yw, nil
+}
+
+// yearWeekOf returns the ISO week of the date of t.
+func yearWeekOf(t time.Time) YearWeek {
+	year, week := t.ISOWeek()
+	return YearWeek{year: year, week: week}
+}
+
+// ToYearWeek returns the ISO week of the GDateTime on its own wall clock, with its week-based year:
+// 2024-12-30 is in 2025-W01.
+func (gdt *GDateTime) ToYearWeek() YearWeek {
+	return yearWeekOf(gdt.t)
+}
+
+// ISOWeekDate returns the ISO 8601 week date of the GDateTime: the week-based year, the week (1-53)
+// and the day of week from 1 (Monday) to 7 (Sunday). Unlike WeekOfYearISO8601 it keeps the year the week belongs to,
+// so 2024-12-30 gives 2025, 1, 1.
+func (gdt *GDateTime) ISOWeekDate() (year, week, weekday int) {
+	year, week = gdt.t.ISOWeek()
+	return year, week, isoDayOfWeek(gdt.t.Weekday())
+}
+
+// FromISOWeekDate Obtains the GDateTime at the start of the day of the ISO 8601 week date in the location,
+// weekday being 1 (Monday) to 7 (Sunday).
+func FromISOWeekDate(year, week, weekday int, loc *time.Location) (*GDateTime, error) {
+	if loc == nil {
+		return nil, ErrNilLocation
+	}
+	yw, err := YearWeekOf(year, week)
+	if err != nil {
+		return nil, err
+	}
+	date, err := yw.AtDay(weekday)
+	if err != nil {
+		return nil, err
+	}
+	return date.AtStartOfDay(loc)
+}
+
+// WithISOWeek returns a copy of the GDateTime moved to the same day of week and time of day in the ISO week (1-53)
+// of its week-based year, so 2024-12-30 with week 2 gives 2025-01-06. A week 53 in a year with 52 weeks returns an error.
+func (gdt *GDateTime) WithISOWeek(week int) (*GDateTime, error) {
+	year, _ := gdt.t.ISOWeek()
+	if err := checkField(chronofield.ISO_WEEK, week, 1, ISOWeeksInYear(year)); err != nil {
+		return nil, err
+	}
+	return gdt.WithYearWeek(YearWeek{year: year, week: week}), nil
+}
+
+// WithYearWeek returns a copy of the GDateTime moved to the same day of week and time of day in the ISO week w.
+func (gdt *GDateTime) WithYearWeek(w YearWeek) *GDateTime {
+	year, month, day := isoWeekDate(w.year, w.week, isoDayOfWeek(gdt.t.Weekday()))
+	newTime := time.Date(year, time.Month(month), day, gdt.t.Hour(), gdt.t.Minute(), gdt.t.Second(), gdt.t.Nanosecond(), gdt.t.Location())
+	return Create(newTime)
+}
+
+// GetYear returns the week-based year of the YearWeek, which may differ from the year of some of its days.
+func (yw YearWeek) GetYear() int {
+	return yw.year
+}
+
+// GetWeek returns the week of the YearWeek (1-53).
+func (yw YearWeek) GetWeek() int {
+	return yw.week
+}
+
+// WeeksInYear returns the number of weeks, 52 or 53, of the week-based year of the YearWeek.
+func (yw YearWeek) WeeksInYear() int {
+	return ISOWeeksInYear(yw.year)
+}
+
+// monday returns the first day of the week.
+func (yw YearWeek) monday() LocalDate {
+	year, month, day := isoWeekDate(yw.year, yw.week, 1)
+	return LocalDate{year: year, month: month, day: day}
+}
+
+// AtDay returns the LocalDate of the day of week of the YearWeek, 1 (Monday) to 7 (Sunday).
+func (yw YearWeek) AtDay(weekday int) (LocalDate, error) {
+	if err := checkField(chronofield.DAY_OF_WEEK, weekday, 1, 7); err != nil {
+		return LocalDate{}, err
+	}
+	return yw.monday().PlusDays(weekday - 1), nil
+}
+
+// AtEndOfWeek returns the LocalDate of the Sunday of the YearWeek.
+func (yw YearWeek) AtEndOfWeek() LocalDate {
+	return yw.monday().PlusDays(6)
+}
+
+// PlusYears adds years to the YearWeek, week 53 becoming week 52 in a year with 52 weeks.
+func (yw YearWeek) PlusYears(years int) YearWeek {
+	year := yw.year + years
+	return YearWeek{year: year, week: min(yw.week, ISOWeeksInYear(year))}
+}
+
+// PlusWeeks adds weeks to the YearWeek, rolling over into the next or previous week-based year.
+func (yw YearWeek) PlusWeeks(weeks int) YearWeek {
+	return yearWeekOf(yw.monday().PlusWeeks(weeks).toTime())
+}
+
+// MinusYears subtracts years from the YearWeek.
+func (yw YearWeek) MinusYears(years int) YearWeek {
+	return yw.PlusYears(-years)
+}
+
+// MinusWeeks subtracts weeks from the YearWeek.
+func (yw YearWeek) MinusWeeks(weeks int) YearWeek {
+	return yw.PlusWeeks(-weeks)
+}
+
+// WeeksUntil returns the number of weeks from this YearWeek to the end YearWeek, negative if end is before.
+func (yw YearWeek) WeeksUntil(end YearWeek) int {
+	return yw.monday().DaysUntil(end.monday()) / 7
+}
+
+// Until iterates over the weeks from this YearWeek up to, but not including, the end YearWeek.
+func (yw YearWeek) Until(end YearWeek) iter.Seq[YearWeek] {
+	return func(yield func(YearWeek) bool) {
+		for current := yw; current.IsBefore(end); current = current.PlusWeeks(1) {
+			if !yield(current) {
+				return
+			}
+		}
+	}
+}
+
+// Days iterates over the seven days of the week, from Monday.
+func (yw YearWeek) Days() iter.Seq[LocalDate] {
+	return daysFrom(yw.monday(), 7)
+}
+
+// CompareTo compares this YearWeek with another, returning -1, 0 or 1.
+func (yw YearWeek) CompareTo(other YearWeek) int {
+	if yw.year != other.year {
+		return compareInt(yw.year, other.year)
+	}
+	return compareInt(yw.week, other.week)
+}
+
+// IsBefore checks if this YearWeek is before the other.
+func (yw YearWeek) IsBefore(other YearWeek) bool {
+	return yw.CompareTo(other) < 0
+}
+
+// IsAfter checks if this YearWeek is after the other.
+func (yw YearWeek) IsAfter(other YearWeek) bool {
+	return yw.CompareTo(other) > 0
+}
+
+// IsEqual checks if this YearWeek is the same week as the other.
+func (yw YearWeek) IsEqual(other YearWeek) bool {
+	return yw == other
+}
+
+// ToFormatString formats the YearWeek based on time package layout specifier, date elements give the Monday.
+func (yw YearWeek) ToFormatString(layout string) string {
+	return yw.monday().toTime().Format(layout)
+}
+
+// Strftime formats the YearWeek with a C style format, date elements give the Monday of the week.
+func (yw YearWeek) Strftime(f string) string {
+	t := yw.monday().toTime()
+	return strftime(&t, f, false)
+}
+
+// String returns the YearWeek as yyyy-Www, such as "2025-W01".
+func (yw YearWeek) String() string {
+	return fmt.Sprintf("%s-W%02d", time.Date(yw.year, time.January, 4, 0, 0, 0, 0, time.UTC).Format("2006"), yw.week)
+}
+
+// MarshalText implements encoding.TextMarshaler using the yyyy-Www format.
+func (yw YearWeek) MarshalText() ([]byte, error) {
+	return []byte(yw.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the yyyy-Www format.
+func (yw *YearWeek) UnmarshalText(text []byte) error {
+	if yw == nil {
+		return errors.New("UnmarshalText on nil pointer")
+	}
+	parsed, err := ParseYearWeek(string(text))
+	if err != nil {
+		return err
+	}
+	*yw = parsed
+	return nil
+}
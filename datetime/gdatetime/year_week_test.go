@@ -0,0 +1,139 @@
+package gdatetime
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestISOWeekDate(t *testing.T) {
+	tests := []struct {
+		date                  time.Time
+		year, week, dayOfWeek int
+	}{
+		{time.Date(2024, 12, 30, 10, 0, 0, 0, time.UTC), 2025, 1, 1},
+		{time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC), 2020, 53, 7},
+		{time.Date(2024, 6, 12, 0, 0, 0, 0, time.UTC), 2024, 24, 3},
+	}
+	for _, tt := range tests {
+		gdt := Create(tt.date)
+		year, week, weekday := gdt.ISOWeekDate()
+		if year != tt.year || week != tt.week || weekday != tt.dayOfWeek {
+			t.Errorf("ISOWeekDate(%s) = %d, %d, %d, want %d, %d, %d", tt.date.Format(time.DateOnly), year, week, weekday, tt.year, tt.week, tt.dayOfWeek)
+		}
+		back, err := FromISOWeekDate(year, week, weekday, time.UTC)
+		if err != nil || !back.t.Equal(gdt.StartOfDay().t) {
+			t.Errorf("FromISOWeekDate(%d, %d, %d) = %v, %v, want %v", year, week, weekday, back, err, gdt.StartOfDay().t)
+		}
+		if got := gdt.ToYearWeek(); got != must(YearWeekOf(tt.year, tt.week)) {
+			t.Errorf("ToYearWeek(%s) = %v", tt.date.Format(time.DateOnly), got)
+		}
+	}
+
+	if _, err := FromISOWeekDate(2025, 53, 1, time.UTC); !errors.Is(err, ErrFieldRange) {
+		t.Errorf("2025 has 52 weeks, week 53 should fail with ErrFieldRange, got %v", err)
+	}
+	if _, err := FromISOWeekDate(2025, 1, 0, time.UTC); !errors.Is(err, ErrFieldRange) {
+		t.Errorf("weekday 0 should fail with ErrFieldRange, got %v", err)
+	}
+	if _, err := FromISOWeekDate(2025, 1, 1, nil); !errors.Is(err, ErrNilLocation) {
+		t.Errorf("a nil location should fail with ErrNilLocation, got %v", err)
+	}
+}
+
+func TestISOWeeksInYear(t *testing.T) {
+	for year, weeks := range map[int]int{2015: 53, 2020: 53, 2024: 52, 2025: 52, 2026: 53} {
+		if got := ISOWeeksInYear(year); got != weeks {
+			t.Errorf("ISOWeeksInYear(%d) = %d, want %d", year, got, weeks)
+		}
+	}
+}
+
+func TestWithISOWeek(t *testing.T) {
+	// Wednesday 2024-06-12 09:30
+	gdt := Create(time.Date(2024, 6, 12, 9, 30, 0, 0, time.UTC))
+	got := gdt.WithYearWeek(must(YearWeekOf(2025, 1)))
+	if want := time.Date(2025, 1, 1, 9, 30, 0, 0, time.UTC); !got.t.Equal(want) {
+		t.Errorf("WithYearWeek(2025-W01) = %v, want %v", got.t, want)
+	}
+
+	if got, err := gdt.WithISOWeek(3); err != nil || !got.t.Equal(time.Date(2024, 1, 17, 9, 30, 0, 0, time.UTC)) {
+		t.Errorf("WithISOWeek(3) failed, got %v, %v", got, err)
+	}
+	// Monday 2024-12-30 is in 2025-W01, so week 2 stays in the week-based year 2025
+	if got, err := Create(time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC)).WithISOWeek(2); err != nil || !got.t.Equal(time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("WithISOWeek(2) in 2025-W01 failed, got %v, %v", got, err)
+	}
+	// 2024 has 52 weeks, 2020 has 53
+	if _, err := gdt.WithISOWeek(53); !errors.Is(err, ErrFieldRange) {
+		t.Errorf("WithISOWeek(53) in 2024 should fail with ErrFieldRange, got %v", err)
+	}
+	if _, err := Create(time.Date(2020, 6, 12, 0, 0, 0, 0, time.UTC)).WithISOWeek(53); err != nil {
+		t.Errorf("WithISOWeek(53) in 2020 returned error: %v", err)
+	}
+}
+
+func TestYearWeekArithmetic(t *testing.T) {
+	yw := must(YearWeekOf(2020, 53))
+	if yw.WeeksInYear() != 53 || yw.GetYear() != 2020 || yw.GetWeek() != 53 {
+		t.Errorf("getters failed for %v", yw)
+	}
+	if got := yw.PlusWeeks(1); got != must(YearWeekOf(2021, 1)) {
+		t.Errorf("PlusWeeks(1) = %v, want 2021-W01", got)
+	}
+	if got := must(YearWeekOf(2021, 1)).MinusWeeks(2); got != must(YearWeekOf(2020, 52)) {
+		t.Errorf("MinusWeeks(2) = %v, want 2020-W52", got)
+	}
+	if got := yw.PlusYears(1); got != must(YearWeekOf(2021, 52)) {
+		t.Errorf("PlusYears(1) = %v, want week 53 clamped to 2021-W52", got)
+	}
+	if got := must(YearWeekOf(2024, 50)).WeeksUntil(must(YearWeekOf(2025, 2))); got != 4 {
+		t.Errorf("WeeksUntil = %d, want 4", got)
+	}
+	weeks := slices.Collect(must(YearWeekOf(2024, 52)).Until(must(YearWeekOf(2025, 2))))
+	if len(weeks) != 2 || weeks[1] != must(YearWeekOf(2025, 1)) {
+		t.Errorf("Until = %v", weeks)
+	}
+	days := slices.Collect(must(YearWeekOf(2025, 1)).Days())
+	if len(days) != 7 || days[0] != must(LocalDateOf(2024, 12, 30)) || days[6] != must(LocalDateOf(2025, 1, 5)) {
+		t.Errorf("Days = %v", days)
+	}
+	if day, err := must(YearWeekOf(2025, 1)).AtDay(3); err != nil || day != must(LocalDateOf(2025, 1, 1)) {
+		t.Errorf("AtDay(3) = %v, %v", day, err)
+	}
+	if must(YearWeekOf(2025, 1)).AtEndOfWeek() != must(LocalDateOf(2025, 1, 5)) {
+		t.Error("AtEndOfWeek failed")
+	}
+	if !must(YearWeekOf(2024, 52)).IsBefore(must(YearWeekOf(2025, 1))) || !yw.IsAfter(must(YearWeekOf(2020, 1))) || !yw.IsEqual(yw) {
+		t.Error("comparison failed")
+	}
+}
+
+func TestYearWeekFormatAndParse(t *testing.T) {
+	yw := must(YearWeekOf(2025, 1))
+	if yw.String() != "2025-W01" || yw.Strftime("%Y-%m-%d") != "2024-12-30" || yw.ToFormatString("2006-01-02") != "2024-12-30" {
+		t.Errorf("formatting failed for %v", yw)
+	}
+	for _, input := range []string{"2025-W01", "2025W01", "2025-w01"} {
+		if got, err := ParseYearWeek(input); err != nil || got != yw {
+			t.Errorf("ParseYearWeek(%s) failed, got %v, %v", input, got, err)
+		}
+	}
+	for _, input := range []string{"2025-W53", "2025-W1", "25-W01", "2025-01"} {
+		if _, err := ParseYearWeek(input); !errors.Is(err, ErrParse) {
+			t.Errorf("ParseYearWeek(%s) should fail with ErrParse, got %v", input, err)
+		}
+	}
+
+	data, _ := json.Marshal(yw)
+	var decoded YearWeek
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded != yw || string(data) != `"2025-W01"` {
+		t.Errorf("JSON round trip failed, got %s, %v, %v", data, decoded, err)
+	}
+	var nilWeek *YearWeek
+	if err := nilWeek.UnmarshalText([]byte("2025-W01")); err == nil {
+		t.Error("UnmarshalText on a nil pointer should fail")
+	}
+}
@@ -0,0 +1,78 @@
+package gdatetime
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// locationCache maps a zone name to its loaded *time.Location, shared by all goroutines.
+var locationCache sync.Map
+
+// LoadLocation returns the location with the IANA name, such as "America/New_York", loading it once and caching it.
+// "UTC" and "" give time.UTC and "Local" gives time.Local. The zone data comes from the system like time.LoadLocation;
+// import the datetime/tzdata package or build with -tags timetzdata to embed it as a fallback.
+func LoadLocation(name string) (*time.Location, error) {
+	switch name {
+	case "", "UTC":
+		return time.UTC, nil
+	case "Local":
+		return time.Local, nil
+	}
+	if loc, ok := locationCache.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w %q: %w", ErrUnknownZone, name, err)
+	}
+	actual, _ := locationCache.LoadOrStore(name, loc)
+	return actual.(*time.Location), nil
+}
+
+// InZone returns the same instant in the zone with the IANA name, such as InZone("Asia/Shanghai").
+// The location is loaded once and cached, an unknown name returns an error matching ErrUnknownZone.
+func (gdt *GDateTime) InZone(name string) (*GDateTime, error) {
+	loc, err := LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+	return gdt.InLocation(loc), nil
+}
+
+// InLocation returns the same instant in the location, changing the wall clock. A nil location is treated as UTC.
+func (gdt *GDateTime) InLocation(loc *time.Location) *GDateTime {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return Create(gdt.t.In(loc))
+}
+
+// AtSameLocalTimeIn returns the same wall clock in the location, changing the instant, so 09:00 in Shanghai gives 09:00 in New York.
+// A wall clock skipped or repeated by a DST change in the location is resolved with DSTEarlierOffset. A nil location is treated as UTC.
+func (gdt *GDateTime) AtSameLocalTimeIn(loc *time.Location) *GDateTime {
+	if loc == nil {
+		loc = time.UTC
+	}
+	t, _ := resolveLocal(gdt.ToLocalDateTime(), loc, DSTEarlierOffset)
+	return Create(t)
+}
+
+// canonicalLocation returns the shared *time.Location for a copy of a location, such as time.UTC for *time.UTC
+// or time.Local for *time.Local, when it gives the same offset as the copy at the instant. Otherwise the copy itself is returned.
+func canonicalLocation(copied *time.Location, at time.Time) *time.Location {
+	name := copied.String()
+	loc, err := LoadLocation(name)
+	// with TZ set, time.Local is named after its zone, such as "America/New_York", rather than "Local"
+	if name == time.Local.String() && loc != time.UTC {
+		loc, err = time.Local, nil
+	}
+	if err != nil {
+		return copied
+	}
+	_, want := at.In(copied).Zone()
+	if _, got := at.In(loc).Zone(); got != want {
+		return copied
+	}
+	return loc
+}
@@ -0,0 +1,252 @@
+package gdatetime
+
+import (
+	_ "embed"
+	"encoding/xml"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+//go:embed windows_zones.xml
+var windowsZonesXML []byte
+
+// windowsZones maps the lower case Windows time zone IDs to IANA names, loaded once from windows_zones.xml.
+var windowsZones = sync.OnceValue(func() map[string]string {
+	var data struct {
+		MapZones []struct {
+			Other     string `xml:"other,attr"`
+			Territory string `xml:"territory,attr"`
+			Type      string `xml:"type,attr"`
+		} `xml:"windowsZones>mapTimezones>mapZone"`
+	}
+	if err := xml.Unmarshal(windowsZonesXML, &data); err != nil {
+		panic("gdatetime: invalid embedded windows_zones.xml: " + err.Error())
+	}
+	zones := make(map[string]string, len(data.MapZones))
+	for _, mapZone := range data.MapZones {
+		if mapZone.Territory == "001" {
+			zones[strings.ToLower(mapZone.Other)] = strings.Fields(mapZone.Type)[0]
+		}
+	}
+	return zones
+})
+
+// abbreviationZones maps a zone abbreviation such as "CST" to the IANA zones of windows_zones.xml using it this year,
+// sorted by name. Zones missing from the system are skipped.
+var abbreviationZones = sync.OnceValue(func() map[string][]string {
+	ianaNames := make([]string, 0, len(windowsZones()))
+	for _, iana := range windowsZones() {
+		ianaNames = append(ianaNames, iana)
+	}
+	// the map has no order, sort by name so the candidates are stable
+	slices.Sort(ianaNames)
+
+	year := time.Now().Year()
+	zones := make(map[string][]string)
+	for _, iana := range ianaNames {
+		loc, err := LoadLocation(iana)
+		if err != nil {
+			continue
+		}
+		for _, abbreviation := range zoneAbbreviations(loc, year) {
+			if !slices.Contains(zones[abbreviation], iana) {
+				zones[abbreviation] = append(zones[abbreviation], iana)
+			}
+		}
+	}
+	return zones
+})
+
+// defaultAbbreviationPreferences lists the zones tried first for common abbreviations used by several zones.
+var defaultAbbreviationPreferences = map[string][]string{
+	"EST":  {"America/New_York"},
+	"EDT":  {"America/New_York"},
+	"CST":  {"America/Chicago"},
+	"CDT":  {"America/Chicago"},
+	"MST":  {"America/Denver"},
+	"MDT":  {"America/Denver"},
+	"PST":  {"America/Los_Angeles"},
+	"PDT":  {"America/Los_Angeles"},
+	"IST":  {"Asia/Kolkata"},
+	"BST":  {"Europe/London"},
+	"CET":  {"Europe/Paris"},
+	"CEST": {"Europe/Paris"},
+	"EET":  {"Europe/Athens"},
+	"EEST": {"Europe/Athens"},
+	"AEST": {"Australia/Sydney"},
+	"AEDT": {"Australia/Sydney"},
+}
+
+var fixedZonePattern = regexp.MustCompile(`^(?i:UTC|GMT|UT)?([+-])([0-9]{1,2})(?::?([0-9]{2}))?(?::?([0-9]{2}))?$`)
+
+var abbreviationPattern = regexp.MustCompile(`^[A-Za-z]{2,6}$`)
+
+// ZoneResolver turns the zone names found in data from other systems into locations:
+// IANA names such as "Asia/Shanghai", Windows IDs such as "China Standard Time",
+// abbreviations such as "CST" and fixed offsets such as "UTC+8", "GMT-05:30" or "+0800".
+// An abbreviation used by several zones resolves to the first of Candidates, which can be configured with Prefer.
+// A ZoneResolver is safe for concurrent use.
+type ZoneResolver struct {
+	mu          sync.RWMutex
+	preferences map[string][]string
+}
+
+// zoneKind tells which notation a zone name was resolved from.
+type zoneKind int
+
+const (
+	zoneFixed zoneKind = iota
+	zoneWindows
+	zoneAbbreviation
+	zoneIANA
+)
+
+// DefaultZoneResolver is the ZoneResolver used by ResolveZone and Strptime.
+var DefaultZoneResolver = NewZoneResolver()
+
+// NewZoneResolver creates a ZoneResolver with the default preferences, such as America/Chicago for "CST".
+func NewZoneResolver() *ZoneResolver {
+	preferences := make(map[string][]string, len(defaultAbbreviationPreferences))
+	for abbreviation, zones := range defaultAbbreviationPreferences {
+		preferences[abbreviation] = zones
+	}
+	return &ZoneResolver{preferences: preferences}
+}
+
+// ResolveZone resolves the zone name with DefaultZoneResolver.
+func ResolveZone(name string) (*time.Location, error) {
+	return DefaultZoneResolver.Resolve(name)
+}
+
+// Prefer sets the zones tried first, in order, for the abbreviation, such as Prefer("CST", "Asia/Shanghai").
+// Calling it without zones restores the order of the data.
+func (r *ZoneResolver) Prefer(abbreviation string, zones ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	abbreviation = strings.ToUpper(abbreviation)
+	if len(zones) == 0 {
+		delete(r.preferences, abbreviation)
+		return
+	}
+	r.preferences[abbreviation] = append([]string(nil), zones...)
+}
+
+// Candidates returns the IANA zones that may be meant by the abbreviation, the preferred ones first.
+func (r *ZoneResolver) Candidates(abbreviation string) []string {
+	abbreviation = strings.ToUpper(abbreviation)
+	r.mu.RLock()
+	candidates := append([]string(nil), r.preferences[abbreviation]...)
+	r.mu.RUnlock()
+	for _, zone := range abbreviationZones()[abbreviation] {
+		if !slices.Contains(candidates, zone) {
+			candidates = append(candidates, zone)
+		}
+	}
+	return candidates
+}
+
+// Resolve returns the location for the zone name, trying in order a fixed offset, a Windows ID,
+// an abbreviation and an IANA name. An unknown name returns an error matching ErrUnknownZone.
+func (r *ZoneResolver) Resolve(name string) (*time.Location, error) {
+	loc, _, err := r.resolve(name)
+	return loc, err
+}
+
+// resolve is Resolve that also tells which notation matched.
+func (r *ZoneResolver) resolve(name string) (*time.Location, zoneKind, error) {
+	name = strings.TrimSpace(name)
+	if loc, err := ParseFixedZone(name); err == nil {
+		return loc, zoneFixed, nil
+	}
+	if iana, ok := WindowsZoneToIANA(name); ok {
+		loc, err := LoadLocation(iana)
+		return loc, zoneWindows, err
+	}
+	if abbreviationPattern.MatchString(name) {
+		for _, zone := range r.Candidates(name) {
+			if loc, err := LoadLocation(zone); err == nil {
+				return loc, zoneAbbreviation, nil
+			}
+		}
+	}
+	if name != "" && name != "Local" {
+		if loc, err := LoadLocation(name); err == nil {
+			return loc, zoneIANA, nil
+		}
+	}
+	return nil, zoneIANA, fmt.Errorf("%w %q", ErrUnknownZone, name)
+}
+
+// WindowsZoneToIANA returns the IANA zone for a Windows time zone ID, such as "Asia/Shanghai" for "China Standard Time".
+// The mapping is the territory "001" data of the CLDR windowsZones.xml, the ID is matched ignoring case.
+func WindowsZoneToIANA(windowsID string) (string, bool) {
+	iana, ok := windowsZones()[strings.ToLower(strings.TrimSpace(windowsID))]
+	return iana, ok
+}
+
+// ParseFixedZone parses a fixed offset such as "UTC+8", "GMT-05:30", "+0800", "+08:00" or "Z" into a fixed zone.
+// "UTC", "GMT", "UT", "Z" and a zero offset give time.UTC.
+func ParseFixedZone(s string) (*time.Location, error) {
+	switch strings.ToUpper(s) {
+	case "Z", "UTC", "GMT", "UT":
+		return time.UTC, nil
+	}
+	match := fixedZonePattern.FindStringSubmatch(s)
+	if match == nil {
+		return nil, fmt.Errorf("%w %q: not a fixed offset", ErrUnknownZone, s)
+	}
+	hours, _ := strconv.Atoi(match[2])
+	minutes, _ := strconv.Atoi(match[3])
+	seconds, _ := strconv.Atoi(match[4])
+	if hours > 18 || minutes > 59 || seconds > 59 {
+		return nil, fmt.Errorf("%w %q: offset out of range", ErrUnknownZone, s)
+	}
+	offset := hours*3600 + minutes*60 + seconds
+	if match[1] == "-" {
+		offset = -offset
+	}
+	if offset == 0 {
+		return time.UTC, nil
+	}
+	return time.FixedZone(formatOffset(offset), offset), nil
+}
+
+// formatOffset formats an offset in seconds as "+08:00", with seconds only when they are not zero.
+func formatOffset(offset int) string {
+	sign := '+'
+	if offset < 0 {
+		sign, offset = '-', -offset
+	}
+	if offset%60 != 0 {
+		return fmt.Sprintf("%c%02d:%02d:%02d", sign, offset/3600, offset/60%60, offset%60)
+	}
+	return fmt.Sprintf("%c%02d:%02d", sign, offset/3600, offset/60%60)
+}
+
+// zoneAbbreviations returns the alphabetic abbreviations used by the location in January and July of the year.
+func zoneAbbreviations(loc *time.Location, year int) []string {
+	var abbreviations []string
+	for _, month := range []time.Month{time.January, time.July} {
+		name, _ := time.Date(year, month, 1, 12, 0, 0, 0, loc).Zone()
+		if abbreviationPattern.MatchString(name) && !slices.Contains(abbreviations, name) {
+			abbreviations = append(abbreviations, name)
+		}
+	}
+	return abbreviations
+}
+
+// abbreviationOffset returns the offset the location uses with the abbreviation in the year, probing January and July.
+func abbreviationOffset(loc *time.Location, abbreviation string, year int) (int, bool) {
+	for _, month := range []time.Month{time.January, time.July} {
+		name, offset := time.Date(year, month, 1, 12, 0, 0, 0, loc).Zone()
+		if strings.EqualFold(name, abbreviation) {
+			return offset, true
+		}
+	}
+	return 0, false
+}
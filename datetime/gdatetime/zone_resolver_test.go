@@ -0,0 +1,114 @@
+package gdatetime
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWindowsZoneToIANA(t *testing.T) {
+	tests := map[string]string{
+		"China Standard Time":     "Asia/Shanghai",
+		"eastern standard time":   "America/New_York",
+		"W. Europe Standard Time": "Europe/Berlin",
+	}
+	for windowsID, want := range tests {
+		if got, ok := WindowsZoneToIANA(windowsID); !ok || got != want {
+			t.Errorf("WindowsZoneToIANA(%q) = %q, %v, want %q", windowsID, got, ok, want)
+		}
+	}
+	if _, ok := WindowsZoneToIANA("Mars Standard Time"); ok {
+		t.Error("WindowsZoneToIANA of an unknown ID should fail")
+	}
+}
+
+func TestParseFixedZone(t *testing.T) {
+	tests := map[string]int{
+		"UTC+8":     8 * 3600,
+		"GMT-05:30": -(5*3600 + 30*60),
+		"+0800":     8 * 3600,
+		"+08:00":    8 * 3600,
+		"-03":       -3 * 3600,
+		"utc+5:45":  5*3600 + 45*60,
+	}
+	for s, want := range tests {
+		loc, err := ParseFixedZone(s)
+		if err != nil {
+			t.Errorf("ParseFixedZone(%q) failed: %v", s, err)
+			continue
+		}
+		if _, offset := time.Date(2024, 1, 1, 0, 0, 0, 0, loc).Zone(); offset != want {
+			t.Errorf("ParseFixedZone(%q) offset = %d, want %d", s, offset, want)
+		}
+	}
+	for _, s := range []string{"Z", "UTC", "GMT", "+00:00"} {
+		if loc, err := ParseFixedZone(s); err != nil || loc != time.UTC {
+			t.Errorf("ParseFixedZone(%q) should return time.UTC, got %v, %v", s, loc, err)
+		}
+	}
+	if loc, _ := ParseFixedZone("-0530"); loc.String() != "-05:30" {
+		t.Errorf("ParseFixedZone name = %q, want -05:30", loc.String())
+	}
+	for _, s := range []string{"+19:00", "+08:60", "8", "UTC+", "Asia/Shanghai"} {
+		if _, err := ParseFixedZone(s); !errors.Is(err, ErrUnknownZone) {
+			t.Errorf("ParseFixedZone(%q) should fail with ErrUnknownZone, got %v", s, err)
+		}
+	}
+}
+
+func TestZoneResolverResolve(t *testing.T) {
+	r := NewZoneResolver()
+	tests := map[string]string{
+		"Asia/Shanghai":       "Asia/Shanghai",
+		"China Standard Time": "Asia/Shanghai",
+		"EST":                 "America/New_York",
+		"CST":                 "America/Chicago",
+		"UTC+8":               "+08:00",
+		"UTC":                 "UTC",
+	}
+	for name, want := range tests {
+		loc, err := r.Resolve(name)
+		if err != nil {
+			t.Skipf("%s not available: %v", name, err)
+		}
+		if loc.String() != want {
+			t.Errorf("Resolve(%q) = %q, want %q", name, loc.String(), want)
+		}
+	}
+	if _, err := r.Resolve("Nowhere Standard Time"); !errors.Is(err, ErrUnknownZone) {
+		t.Errorf("Resolve of an unknown name should fail with ErrUnknownZone, got %v", err)
+	}
+}
+
+func TestZoneResolverPrefer(t *testing.T) {
+	r := NewZoneResolver()
+	r.Prefer("cst", "Asia/Shanghai")
+	if candidates := r.Candidates("CST"); len(candidates) == 0 || candidates[0] != "Asia/Shanghai" {
+		t.Errorf("Candidates(CST) = %v, want Asia/Shanghai first", candidates)
+	}
+	loc, err := r.Resolve("CST")
+	if err != nil {
+		t.Skip("Asia/Shanghai not available")
+	}
+	if loc.String() != "Asia/Shanghai" {
+		t.Errorf("Resolve(CST) after Prefer = %q, want Asia/Shanghai", loc.String())
+	}
+	if loc, _ := DefaultZoneResolver.Resolve("CST"); loc.String() != "America/Chicago" {
+		t.Error("Prefer on a resolver should not change DefaultZoneResolver")
+	}
+
+	r.Prefer("CST")
+	candidates := r.Candidates("CST")
+	if len(candidates) < 2 {
+		t.Fatalf("Candidates(CST) from the data = %v, want several zones", candidates)
+	}
+	for _, zone := range []string{"America/Chicago", "Asia/Shanghai"} {
+		found := false
+		for _, candidate := range candidates {
+			found = found || candidate == zone
+		}
+		if !found {
+			t.Errorf("Candidates(CST) = %v, missing %s", candidates, zone)
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package gdatetime
+
+import (
+	"time"
+
+	"github.com/linsongze/go-date-time/datetime/timeconst"
+)
+
+// transitionProbeStep is the interval at which a location is probed for offset changes.
+// Two transitions closer than this could be missed, which does not happen in the tz database.
+const transitionProbeStep = timeconst.SECONDS_PER_DAY
+
+// transitionSearchYears bounds how far NextTransition and PreviousTransition look,
+// enough to reach the first transition of every zone from today.
+const transitionSearchYears = 200
+
+// TimeZoneRules answers questions about the offset changes of a location, see ZoneRules.
+type TimeZoneRules struct {
+	loc *time.Location
+}
+
+// ZoneTransition is a change of offset in a location, such as the start of DST.
+type ZoneTransition struct {
+	Instant      *GDateTime // the first instant with the new offset, in the location
+	OffsetBefore int        // offset in seconds before the transition
+	OffsetAfter  int        // offset in seconds from the transition on
+}
+
+// ZoneRules returns the rules of the location, a nil location is treated as UTC.
+// Go does not expose the transitions of a time.Location, so they are found by probing the location
+// at regular intervals and binary-searching for the exact second where the offset changes.
+func ZoneRules(loc *time.Location) *TimeZoneRules {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return &TimeZoneRules{loc: loc}
+}
+
+// Location returns the location of the rules.
+func (r *TimeZoneRules) Location() *time.Location {
+	return r.loc
+}
+
+// Transitions returns the transitions from the from instant, inclusive, to the to instant, exclusive, in order.
+func (r *TimeZoneRules) Transitions(from, to *GDateTime) []ZoneTransition {
+	var transitions []ZoneTransition
+	start, end := from.t.Unix()-1, to.t.Unix()-1
+	for {
+		transition, ok := r.nextAfter(start, end)
+		if !ok {
+			return transitions
+		}
+		transitions = append(transitions, transition)
+		start = transition.Instant.t.Unix()
+	}
+}
+
+// NextTransition returns the first transition after the GDateTime, false if there is none.
+func (r *TimeZoneRules) NextTransition(gdt *GDateTime) (ZoneTransition, bool) {
+	start := gdt.t.Unix()
+	return r.nextAfter(start, start+transitionSearchYears*366*timeconst.SECONDS_PER_DAY)
+}
+
+// PreviousTransition returns the last transition at or before the GDateTime, false if there is none.
+func (r *TimeZoneRules) PreviousTransition(gdt *GDateTime) (ZoneTransition, bool) {
+	end := gdt.t.Unix()
+	limit := end - transitionSearchYears*366*timeconst.SECONDS_PER_DAY
+	offset := offsetAt(end, r.loc)
+	for probe := end; probe > limit; {
+		previous := max(probe-transitionProbeStep, limit)
+		if offsetAt(previous, r.loc) != offset {
+			return r.transitionBetween(previous, probe), true
+		}
+		probe = previous
+	}
+	return ZoneTransition{}, false
+}
+
+// nextAfter returns the first transition in the seconds start+1 to end, false if there is none.
+func (r *TimeZoneRules) nextAfter(start, end int64) (ZoneTransition, bool) {
+	offset := offsetAt(start, r.loc)
+	for probe := start; probe < end; {
+		next := min(probe+transitionProbeStep, end)
+		if offsetAt(next, r.loc) != offset {
+			return r.transitionBetween(probe, next), true
+		}
+		probe = next
+	}
+	return ZoneTransition{}, false
+}
+
+// transitionBetween binary-searches the first second after lo where the offset differs from the offset at lo, up to hi.
+func (r *TimeZoneRules) transitionBetween(lo, hi int64) ZoneTransition {
+	before := offsetAt(lo, r.loc)
+	for lo+1 < hi {
+		mid := lo + (hi-lo)/2
+		if offsetAt(mid, r.loc) == before {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return ZoneTransition{Instant: Create(time.Unix(hi, 0).In(r.loc)), OffsetBefore: before, OffsetAfter: offsetAt(hi, r.loc)}
+}
+
+// OffsetAt returns the offset in seconds of the location at the instant of the GDateTime.
+func (r *TimeZoneRules) OffsetAt(gdt *GDateTime) int {
+	_, offset := gdt.t.In(r.loc).Zone()
+	return offset
+}
+
+// IsDST checks if daylight saving time is in effect in the location at the instant of the GDateTime.
+func (r *TimeZoneRules) IsDST(gdt *GDateTime) bool {
+	return gdt.t.In(r.loc).IsDST()
+}
+
+// StandardOffset returns the offset in seconds of the location at the instant without daylight saving time,
+// that is the offset of the nearest period, forward or backward, where DST is not in effect.
+func (r *TimeZoneRules) StandardOffset(gdt *GDateTime) int {
+	t := gdt.t.In(r.loc)
+	if !t.IsDST() {
+		_, offset := t.Zone()
+		return offset
+	}
+	for days := 1; days <= 2*366; days++ {
+		for _, probe := range []time.Time{t.AddDate(0, 0, -days), t.AddDate(0, 0, days)} {
+			if !probe.IsDST() {
+				_, offset := probe.Zone()
+				return offset
+			}
+		}
+	}
+	_, offset := t.Zone()
+	return offset
+}
+
+// IsValidLocal checks if the local date-time exists in the location, false when it is skipped by a DST gap.
+// A local date-time repeated by an overlap is valid.
+func (r *TimeZoneRules) IsValidLocal(ldt LocalDateTime) bool {
+	instants, _, _ := localInstants(ldt, r.loc)
+	return len(instants) > 0
+}
+
+// ValidOffsets returns the offsets in seconds that the local date-time can have in the location:
+// none in a gap, two in an overlap with the earlier instant first, and one otherwise.
+func (r *TimeZoneRules) ValidOffsets(ldt LocalDateTime) []int {
+	instants, _, _ := localInstants(ldt, r.loc)
+	offsets := make([]int, 0, len(instants))
+	for _, instant := range instants {
+		_, offset := instant.Zone()
+		offsets = append(offsets, offset)
+	}
+	return offsets
+}
+
+// IsGap checks if the transition skips local time, such as the start of DST.
+func (zt ZoneTransition) IsGap() bool {
+	return zt.OffsetAfter > zt.OffsetBefore
+}
+
+// IsOverlap checks if the transition repeats local time, such as the end of DST.
+func (zt ZoneTransition) IsOverlap() bool {
+	return zt.OffsetAfter < zt.OffsetBefore
+}
+
+// Duration returns the change of offset, positive for a gap and negative for an overlap.
+func (zt ZoneTransition) Duration() time.Duration {
+	return time.Duration(zt.OffsetAfter-zt.OffsetBefore) * time.Second
+}
+
+// DateTimeBefore returns the local date-time of the transition with the offset before it, such as 02:00 for the start of DST in New York.
+func (zt ZoneTransition) DateTimeBefore() LocalDateTime {
+	return localDateTimeOf(zt.Instant.t.UTC().Add(time.Duration(zt.OffsetBefore) * time.Second))
+}
+
+// DateTimeAfter returns the local date-time of the transition with the offset after it, such as 03:00 for the start of DST in New York.
+func (zt ZoneTransition) DateTimeAfter() LocalDateTime {
+	return zt.Instant.ToLocalDateTime()
+}
+
+// Offset returns the offset from UTC of the GDateTime in seconds, such as 28800 for +08:00.
+func (gdt *GDateTime) Offset() int {
+	_, offset := gdt.t.Zone()
+	return offset
+}
+
+// ZoneName returns the abbreviated name of the zone of the GDateTime at its instant, such as "EST" or "EDT".
+func (gdt *GDateTime) ZoneName() string {
+	name, _ := gdt.t.Zone()
+	return name
+}
@@ -0,0 +1,114 @@
+package gdatetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestZoneRulesTransitions(t *testing.T) {
+	loc := loadNewYork(t)
+	rules := ZoneRules(loc)
+	from := Create(time.Date(2024, 1, 1, 0, 0, 0, 0, loc))
+	to := Create(time.Date(2025, 1, 1, 0, 0, 0, 0, loc))
+
+	transitions := rules.Transitions(from, to)
+	if len(transitions) != 2 {
+		t.Fatalf("Transitions should find 2 changes in 2024, got %d", len(transitions))
+	}
+
+	spring, fall := transitions[0], transitions[1]
+	if !spring.Instant.t.Equal(time.Date(2024, 3, 10, 7, 0, 0, 0, time.UTC)) || !spring.IsGap() || spring.IsOverlap() {
+		t.Errorf("spring transition failed, got %+v at %v", spring, spring.Instant.t)
+	}
+	if spring.OffsetBefore != -5*3600 || spring.OffsetAfter != -4*3600 || spring.Duration() != time.Hour {
+		t.Errorf("spring offsets failed, got %+v", spring)
+	}
+	if spring.DateTimeBefore() != must(LocalDateTimeOf(2024, 3, 10, 2, 0, 0, 0)) || spring.DateTimeAfter() != must(LocalDateTimeOf(2024, 3, 10, 3, 0, 0, 0)) {
+		t.Errorf("spring local date-times failed, got %v and %v", spring.DateTimeBefore(), spring.DateTimeAfter())
+	}
+	if !fall.Instant.t.Equal(time.Date(2024, 11, 3, 6, 0, 0, 0, time.UTC)) || !fall.IsOverlap() || fall.Duration() != -time.Hour {
+		t.Errorf("fall transition failed, got %+v at %v", fall, fall.Instant.t)
+	}
+
+	// from is inclusive and to is exclusive
+	if got := rules.Transitions(spring.Instant, fall.Instant); len(got) != 1 || !got[0].Instant.t.Equal(spring.Instant.t) {
+		t.Errorf("Transitions bounds failed, got %v", got)
+	}
+}
+
+func TestZoneRulesNextAndPrevious(t *testing.T) {
+	loc := loadNewYork(t)
+	rules := ZoneRules(loc)
+	summer := Create(time.Date(2024, 7, 1, 0, 0, 0, 0, loc))
+
+	next, ok := rules.NextTransition(summer)
+	if !ok || !next.Instant.t.Equal(time.Date(2024, 11, 3, 6, 0, 0, 0, time.UTC)) {
+		t.Errorf("NextTransition failed, got %v, %v", next.Instant, ok)
+	}
+	previous, ok := rules.PreviousTransition(summer)
+	if !ok || !previous.Instant.t.Equal(time.Date(2024, 3, 10, 7, 0, 0, 0, time.UTC)) {
+		t.Errorf("PreviousTransition failed, got %v, %v", previous.Instant, ok)
+	}
+	if again, ok := rules.PreviousTransition(previous.Instant); !ok || !again.Instant.t.Equal(previous.Instant.t) {
+		t.Errorf("PreviousTransition should include the instant itself, got %v", again.Instant)
+	}
+
+	if _, ok := ZoneRules(time.UTC).NextTransition(summer); ok {
+		t.Error("UTC should have no transition")
+	}
+	if _, ok := ZoneRules(nil).PreviousTransition(summer); ok {
+		t.Error("a nil location is UTC and should have no transition")
+	}
+}
+
+func TestZoneRulesOffsets(t *testing.T) {
+	loc := loadNewYork(t)
+	rules := ZoneRules(loc)
+	summer := Create(time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC))
+	winter := Create(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	if rules.OffsetAt(summer) != -4*3600 || rules.OffsetAt(winter) != -5*3600 {
+		t.Errorf("OffsetAt failed")
+	}
+	if !rules.IsDST(summer) || rules.IsDST(winter) {
+		t.Errorf("IsDST failed")
+	}
+	if rules.StandardOffset(summer) != -5*3600 || rules.StandardOffset(winter) != -5*3600 {
+		t.Errorf("StandardOffset failed, got %d", rules.StandardOffset(summer))
+	}
+	if rules.Location() != loc {
+		t.Errorf("Location failed")
+	}
+}
+
+func TestZoneRulesIsValidLocal(t *testing.T) {
+	loc := loadNewYork(t)
+	rules := ZoneRules(loc)
+	gap := must(LocalDateTimeOf(2024, 3, 10, 2, 30, 0, 0))
+	overlap := must(LocalDateTimeOf(2024, 11, 3, 1, 30, 0, 0))
+	normal := must(LocalDateTimeOf(2024, 7, 1, 12, 0, 0, 0))
+
+	if rules.IsValidLocal(gap) || !rules.IsValidLocal(overlap) || !rules.IsValidLocal(normal) {
+		t.Errorf("IsValidLocal failed")
+	}
+	if got := rules.ValidOffsets(gap); len(got) != 0 {
+		t.Errorf("ValidOffsets in a gap should be empty, got %v", got)
+	}
+	if got := rules.ValidOffsets(overlap); len(got) != 2 || got[0] != -4*3600 || got[1] != -5*3600 {
+		t.Errorf("ValidOffsets in an overlap failed, got %v", got)
+	}
+	if got := rules.ValidOffsets(normal); len(got) != 1 || got[0] != -4*3600 {
+		t.Errorf("ValidOffsets failed, got %v", got)
+	}
+}
+
+func TestOffsetAndZoneName(t *testing.T) {
+	loc := loadNewYork(t)
+	gdt := Create(time.Date(2024, 7, 1, 12, 0, 0, 0, loc))
+	if gdt.Offset() != -4*3600 || gdt.ZoneName() != "EDT" {
+		t.Errorf("Offset/ZoneName failed, got %d %s", gdt.Offset(), gdt.ZoneName())
+	}
+	if winter := gdt.MinusMonths(6); winter.Offset() != -5*3600 || winter.ZoneName() != "EST" {
+		t.Errorf("Offset/ZoneName in winter failed, got %d %s", winter.Offset(), winter.ZoneName())
+	}
+}
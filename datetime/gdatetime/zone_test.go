@@ -0,0 +1,107 @@
+package gdatetime
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestLoadLocationCache(t *testing.T) {
+	first, err := LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Skip("Asia/Shanghai not available")
+	}
+	second, _ := LoadLocation("Asia/Shanghai")
+	if first != second {
+		t.Error("LoadLocation should return the cached location")
+	}
+	if loc, _ := LoadLocation("UTC"); loc != time.UTC {
+		t.Error("LoadLocation(UTC) should return time.UTC")
+	}
+	if loc, _ := LoadLocation("Local"); loc != time.Local {
+		t.Error("LoadLocation(Local) should return time.Local")
+	}
+	if _, err := LoadLocation("Mars/Olympus_Mons"); !errors.Is(err, ErrUnknownZone) {
+		t.Errorf("LoadLocation of an unknown zone should fail with ErrUnknownZone, got %v", err)
+	}
+
+	var wg sync.WaitGroup
+	results := make([]*time.Location, 16)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], _ = LoadLocation("Europe/Paris")
+		}(i)
+	}
+	wg.Wait()
+	for _, loc := range results {
+		if loc == nil || loc != results[0] {
+			t.Fatal("concurrent LoadLocation should share one location")
+		}
+	}
+}
+
+func TestInZone(t *testing.T) {
+	gdt := Create(time.Date(2024, 6, 3, 12, 0, 0, 0, time.UTC))
+	shanghai, err := gdt.InZone("Asia/Shanghai")
+	if err != nil {
+		t.Skip("Asia/Shanghai not available")
+	}
+	if !shanghai.t.Equal(gdt.t) || shanghai.GetHour() != 20 || shanghai.t.Location().String() != "Asia/Shanghai" {
+		t.Errorf("InZone failed, got %v", shanghai.t)
+	}
+	if _, err := gdt.InZone("Nowhere/Unknown"); !errors.Is(err, ErrUnknownZone) {
+		t.Errorf("InZone of an unknown zone should fail with ErrUnknownZone, got %v", err)
+	}
+}
+
+func TestInLocation(t *testing.T) {
+	loc := loadNewYork(t)
+	gdt := Create(time.Date(2024, 6, 3, 12, 0, 0, 0, time.UTC))
+	if got := gdt.InLocation(loc); !got.t.Equal(gdt.t) || got.GetHour() != 8 {
+		t.Errorf("InLocation failed, got %v", got.t)
+	}
+	if got := gdt.InLocation(nil); got.t.Location() != time.UTC {
+		t.Errorf("InLocation(nil) should use UTC, got %v", got.t)
+	}
+}
+
+func TestAtSameLocalTimeIn(t *testing.T) {
+	loc := loadNewYork(t)
+	shanghai := time.FixedZone("CST", 8*3600)
+	gdt := Create(time.Date(2024, 6, 3, 9, 0, 0, 0, shanghai))
+
+	got := gdt.AtSameLocalTimeIn(loc)
+	if got.ToLocalDateTime() != gdt.ToLocalDateTime() || !got.t.Equal(time.Date(2024, 6, 3, 13, 0, 0, 0, time.UTC)) {
+		t.Errorf("AtSameLocalTimeIn failed, got %v", got.t)
+	}
+
+	// 02:30 does not exist in New York on 2024-03-10
+	inGap := Create(time.Date(2024, 3, 10, 2, 30, 0, 0, shanghai)).AtSameLocalTimeIn(loc)
+	if inGap.GetHour() != 3 || inGap.GetMinute() != 30 {
+		t.Errorf("AtSameLocalTimeIn in a gap should move forward, got %v", inGap.t)
+	}
+}
+
+func TestConvertToZoneKeepsSharedLocations(t *testing.T) {
+	gdt := Create(time.Date(2024, 6, 3, 12, 0, 0, 0, time.FixedZone("", 3600)))
+	if got := gdt.ConvertToZone(*time.UTC); got.t.Location() != time.UTC {
+		t.Errorf("ConvertToZone(*time.UTC) should use time.UTC, got %v", got.t.Location())
+	}
+	// a local zone named "UTC", as with TZ unset or TZ=UTC, cannot be told apart from *time.UTC
+	wantLocal := time.Local
+	if time.Local.String() == "UTC" {
+		wantLocal = time.UTC
+	}
+	if got := gdt.ConvertToZone(*time.Local); got.t.Location() != wantLocal {
+		t.Errorf("ConvertToZone(*time.Local) should use %v, got %v", wantLocal, got.t.Location())
+	}
+
+	// a custom fixed zone that happens to share the name of a zone file is kept as given
+	custom := time.FixedZone("EST", 3*3600)
+	if got := gdt.ConvertToZone(*custom); got.Offset() != 3*3600 {
+		t.Errorf("ConvertToZone should keep a custom zone, got offset %d", got.Offset())
+	}
+}
@@ -0,0 +1,165 @@
+package gdatetime
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/linsongze/go-date-time/datetime/timeconst"
+)
+
+// DSTResolution decides which instant a local date-time gets when a DST change skips it (a gap)
+// or makes it occur twice (an overlap). A local date-time outside a transition has a single instant.
+type DSTResolution int
+
+const (
+	// DSTEarlierOffset uses the offset in effect before the transition.
+	// In an overlap this is the earlier instant, in a gap the local time moves forward by the gap length (02:30 gives 03:30).
+	DSTEarlierOffset DSTResolution = iota
+	// DSTLaterOffset uses the offset in effect after the transition.
+	// In an overlap this is the later instant, in a gap the local time moves back by the gap length (02:30 gives 01:30).
+	DSTLaterOffset
+	// DSTShiftForward moves a local time in a gap to the transition, the first valid local time after it (02:30 gives 03:00),
+	// and uses the earlier offset in an overlap.
+	DSTShiftForward
+	// DSTError returns ErrSkippedLocalTime in a gap and ErrAmbiguousLocalTime in an overlap.
+	DSTError
+)
+
+// String returns the name of the DSTResolution.
+func (r DSTResolution) String() string {
+	switch r {
+	case DSTEarlierOffset:
+		return "EarlierOffset"
+	case DSTLaterOffset:
+		return "LaterOffset"
+	case DSTShiftForward:
+		return "ShiftForward"
+	case DSTError:
+		return "Error"
+	default:
+		return fmt.Sprintf("DSTResolution(%d)", int(r))
+	}
+}
+
+// ZonedOf Obtains an instance of GDateTime in the given location, every field is validated strictly
+// and a local date-time in a DST gap or overlap is resolved with the resolution instead of the choice of time.Date.
+func ZonedOf(year, month, dayOfMonth, hour, minute, second, nanoOfSecond int, loc *time.Location, resolution DSTResolution) (*GDateTime, error) {
+	ldt, err := LocalDateTimeOf(year, month, dayOfMonth, hour, minute, second, nanoOfSecond)
+	if err != nil {
+		return nil, err
+	}
+	return ldt.AtZoneResolved(loc, resolution)
+}
+
+// AtZoneResolved places the LocalDateTime in the given location, resolving a DST gap or overlap with the resolution.
+func (ldt LocalDateTime) AtZoneResolved(loc *time.Location, resolution DSTResolution) (*GDateTime, error) {
+	if loc == nil {
+		return nil, ErrNilLocation
+	}
+	t, err := resolveLocal(ldt, loc, resolution)
+	if err != nil {
+		return nil, err
+	}
+	return Create(t), nil
+}
+
+// WithEarlierOffsetAtOverlap returns the earlier of the two instants when the wall clock of the GDateTime
+// is repeated by a DST overlap, such as 01:30 EDT for 2024-11-03 01:30 America/New_York. Otherwise the GDateTime is returned unchanged.
+func (gdt *GDateTime) WithEarlierOffsetAtOverlap() *GDateTime {
+	instants, _, _ := localInstants(gdt.ToLocalDateTime(), gdt.t.Location())
+	if len(instants) == 2 {
+		return Create(instants[0])
+	}
+	return gdt
+}
+
+// WithLaterOffsetAtOverlap returns the later of the two instants when the wall clock of the GDateTime
+// is repeated by a DST overlap, such as 01:30 EST for 2024-11-03 01:30 America/New_York. Otherwise the GDateTime is returned unchanged.
+func (gdt *GDateTime) WithLaterOffsetAtOverlap() *GDateTime {
+	instants, _, _ := localInstants(gdt.ToLocalDateTime(), gdt.t.Location())
+	if len(instants) == 2 {
+		return Create(instants[1])
+	}
+	return gdt
+}
+
+// resolveLocal returns the instant of the local date-time in loc, resolving a gap or an overlap with the resolution.
+func resolveLocal(ldt LocalDateTime, loc *time.Location, resolution DSTResolution) (time.Time, error) {
+	if resolution < DSTEarlierOffset || resolution > DSTError {
+		return time.Time{}, fmt.Errorf("unknown DST resolution %v", resolution)
+	}
+	instants, offsetBefore, offsetAfter := localInstants(ldt, loc)
+	switch len(instants) {
+	case 1:
+		return instants[0], nil
+	case 2:
+		switch resolution {
+		case DSTLaterOffset:
+			return instants[1], nil
+		case DSTError:
+			return time.Time{}, fmt.Errorf("%w: %v in %s", ErrAmbiguousLocalTime, ldt, loc)
+		default:
+			return instants[0], nil
+		}
+	}
+
+	local := localSeconds(ldt)
+	nano := int64(ldt.time.nano)
+	switch resolution {
+	case DSTEarlierOffset:
+		return time.Unix(local-int64(offsetBefore), nano).In(loc), nil
+	case DSTLaterOffset:
+		return time.Unix(local-int64(offsetAfter), nano).In(loc), nil
+	case DSTShiftForward:
+		// the instants around the gap carry the offset before it, and the transition is the first one with the offset after it
+		lo, hi := local-int64(offsetAfter), local-int64(offsetBefore)
+		for lo+1 < hi {
+			mid := lo + (hi-lo)/2
+			if offsetAt(mid, loc) == offsetBefore {
+				lo = mid
+			} else {
+				hi = mid
+			}
+		}
+		return time.Unix(hi, 0).In(loc), nil
+	default:
+		return time.Time{}, fmt.Errorf("%w: %v in %s", ErrSkippedLocalTime, ldt, loc)
+	}
+}
+
+// localInstants returns the instants, in order, whose wall clock in loc is the local date-time:
+// none in a gap, two in an overlap and one otherwise. It also returns the offsets a day before and a day after,
+// which are the offsets on both sides of a transition near the local date-time.
+func localInstants(ldt LocalDateTime, loc *time.Location) ([]time.Time, int, int) {
+	local := localSeconds(ldt)
+	offsetBefore := offsetAt(local-timeconst.SECONDS_PER_DAY, loc)
+	offsetAfter := offsetAt(local+timeconst.SECONDS_PER_DAY, loc)
+
+	var instants []time.Time
+	for _, offset := range []int{offsetBefore, offsetAfter} {
+		instant := local - int64(offset)
+		if offsetAt(instant, loc) != offset {
+			continue
+		}
+		t := time.Unix(instant, int64(ldt.time.nano)).In(loc)
+		if len(instants) == 1 && instants[0].Equal(t) {
+			continue
+		}
+		instants = append(instants, t)
+	}
+	if len(instants) == 2 && instants[1].Before(instants[0]) {
+		instants[0], instants[1] = instants[1], instants[0]
+	}
+	return instants, offsetBefore, offsetAfter
+}
+
+// localSeconds returns the wall clock of the local date-time as seconds since 1970-01-01T00:00:00, ignoring the nanoseconds.
+func localSeconds(ldt LocalDateTime) int64 {
+	return ldt.date.EpochDay()*timeconst.SECONDS_PER_DAY + int64(ldt.time.SecondOfDay())
+}
+
+// offsetAt returns the offset in seconds of loc at the Unix time.
+func offsetAt(unix int64, loc *time.Location) int {
+	_, offset := time.Unix(unix, 0).In(loc).Zone()
+	return offset
+}
@@ -0,0 +1,113 @@
+package gdatetime
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func loadNewYork(t *testing.T) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("America/New_York not available")
+	}
+	return loc
+}
+
+func TestZonedOfOverlap(t *testing.T) {
+	loc := loadNewYork(t)
+	// 2024-11-03 01:30 happens twice in New York, first in EDT (-4) then in EST (-5)
+	edt := time.Date(2024, 11, 3, 5, 30, 0, 0, time.UTC)
+	est := time.Date(2024, 11, 3, 6, 30, 0, 0, time.UTC)
+
+	cases := []struct {
+		resolution DSTResolution
+		expected   time.Time
+	}{
+		{DSTEarlierOffset, edt},
+		{DSTLaterOffset, est},
+		{DSTShiftForward, edt},
+	}
+	for _, c := range cases {
+		got, err := ZonedOf(2024, 11, 3, 1, 30, 0, 0, loc, c.resolution)
+		if err != nil {
+			t.Errorf("ZonedOf with %v returned error: %v", c.resolution, err)
+			continue
+		}
+		if !got.t.Equal(c.expected) || got.GetHour() != 1 || got.GetMinute() != 30 {
+			t.Errorf("ZonedOf with %v failed, expected %v, got %v", c.resolution, c.expected, got.t)
+		}
+	}
+
+	if _, err := ZonedOf(2024, 11, 3, 1, 30, 0, 0, loc, DSTError); !errors.Is(err, ErrAmbiguousLocalTime) {
+		t.Errorf("ZonedOf with DSTError should return ErrAmbiguousLocalTime, got %v", err)
+	}
+}
+
+func TestZonedOfGap(t *testing.T) {
+	loc := loadNewYork(t)
+	// 2024-03-10 02:30 does not exist in New York, the clock jumps from 02:00 EST to 03:00 EDT
+	cases := []struct {
+		resolution DSTResolution
+		expected   time.Time
+	}{
+		{DSTEarlierOffset, time.Date(2024, 3, 10, 7, 30, 0, 0, time.UTC)}, // 03:30 EDT
+		{DSTLaterOffset, time.Date(2024, 3, 10, 6, 30, 0, 0, time.UTC)},   // 01:30 EST
+		{DSTShiftForward, time.Date(2024, 3, 10, 7, 0, 0, 0, time.UTC)},   // 03:00 EDT
+	}
+	for _, c := range cases {
+		got, err := ZonedOf(2024, 3, 10, 2, 30, 0, 500, loc, c.resolution)
+		if err != nil {
+			t.Errorf("ZonedOf with %v returned error: %v", c.resolution, err)
+			continue
+		}
+		expected := c.expected
+		if c.resolution != DSTShiftForward {
+			expected = expected.Add(500)
+		}
+		if !got.t.Equal(expected) {
+			t.Errorf("ZonedOf with %v failed, expected %v, got %v", c.resolution, expected.In(loc), got.t)
+		}
+	}
+
+	if _, err := ZonedOf(2024, 3, 10, 2, 30, 0, 0, loc, DSTError); !errors.Is(err, ErrSkippedLocalTime) {
+		t.Errorf("ZonedOf with DSTError should return ErrSkippedLocalTime, got %v", err)
+	}
+}
+
+func TestZonedOfNoTransition(t *testing.T) {
+	loc := loadNewYork(t)
+	for _, resolution := range []DSTResolution{DSTEarlierOffset, DSTLaterOffset, DSTShiftForward, DSTError} {
+		got, err := ZonedOf(2024, 7, 4, 12, 0, 0, 0, loc, resolution)
+		if err != nil || !got.t.Equal(time.Date(2024, 7, 4, 16, 0, 0, 0, time.UTC)) {
+			t.Errorf("ZonedOf with %v failed, got %v, %v", resolution, got, err)
+		}
+	}
+	if _, err := ZonedOf(2024, 2, 30, 0, 0, 0, 0, loc, DSTEarlierOffset); !errors.Is(err, ErrFieldRange) {
+		t.Errorf("ZonedOf should validate the fields, got %v", err)
+	}
+	if _, err := ZonedOf(2024, 7, 4, 12, 0, 0, 0, nil, DSTEarlierOffset); err != ErrNilLocation {
+		t.Errorf("ZonedOf with a nil location should return ErrNilLocation, got %v", err)
+	}
+	if _, err := ZonedOf(2024, 7, 4, 12, 0, 0, 0, loc, DSTResolution(9)); err == nil {
+		t.Error("ZonedOf with an unknown resolution should fail")
+	}
+}
+
+func TestWithOffsetAtOverlap(t *testing.T) {
+	loc := loadNewYork(t)
+	earlier, _ := ZonedOf(2024, 11, 3, 1, 30, 0, 0, loc, DSTEarlierOffset)
+	later := earlier.WithLaterOffsetAtOverlap()
+	if later.t.Sub(earlier.t) != time.Hour || later.GetHour() != 1 {
+		t.Errorf("WithLaterOffsetAtOverlap failed, got %v", later.t)
+	}
+	if back := later.WithEarlierOffsetAtOverlap(); !back.t.Equal(earlier.t) {
+		t.Errorf("WithEarlierOffsetAtOverlap failed, got %v", back.t)
+	}
+
+	noon := Create(time.Date(2024, 11, 3, 12, 0, 0, 0, loc))
+	if noon.WithLaterOffsetAtOverlap() != noon || noon.WithEarlierOffsetAtOverlap() != noon {
+		t.Error("WithEarlier/LaterOffsetAtOverlap should not change a time outside an overlap")
+	}
+}
@@ -0,0 +1,237 @@
+// Package planner finds meeting slots inside the working hours of participants in several time zones.
+//
+//	slots, err := planner.FindSlots(planner.Request{
+//		Participants: []planner.Participant{
+//			{Name: "Ana", Zone: "America/New_York"},
+//			{Name: "Li", Zone: "Asia/Shanghai", WorkStart: nineThirty, WorkEnd: eighteenThirty},
+//		},
+//		From:     from,
+//		To:       to,
+//		Duration: time.Hour,
+//	})
+//
+// Every slot is inside the working hours of everyone and clear of their busy intervals.
+// The slots are returned in UTC, the most convenient first.
+package planner
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/linsongze/go-date-time/datetime/gdatetime"
+)
+
+// DefaultStep is the interval between candidate slot starts when Request.Step is zero.
+const DefaultStep = 30 * time.Minute
+
+var (
+	// ErrNoParticipants is returned when a Request has no participants.
+	ErrNoParticipants = errors.New("planner: no participants")
+	// ErrInvalidRequest is returned for a Request with a missing range, a non-positive duration or step,
+	// or a participant whose working day is empty.
+	ErrInvalidRequest = errors.New("planner: invalid request")
+)
+
+var (
+	defaultWorkStart, _ = gdatetime.LocalTimeOf(9, 0, 0, 0)
+	defaultWorkEnd, _   = gdatetime.LocalTimeOf(18, 0, 0, 0)
+)
+
+// Participant is a person attending the meeting.
+type Participant struct {
+	Name string
+	// Zone is the IANA name of the time zone of the participant, such as "Asia/Shanghai".
+	Zone string
+	// WorkStart and WorkEnd are the local working hours, 09:00 to 18:00 when both are zero.
+	// A WorkEnd before WorkStart is a working day ending after midnight.
+	WorkStart, WorkEnd gdatetime.LocalTime
+	// WorkDays are the days of the week the participant works, Monday to Friday when empty.
+	WorkDays []time.Weekday
+	// Busy are the intervals the participant is not available, start inclusive and end exclusive.
+	Busy []Busy
+}
+
+// Busy is an interval of time a participant is not available, Start inclusive and End exclusive.
+type Busy struct {
+	Start, End *gdatetime.GDateTime
+}
+
+// Request describes the meeting to plan.
+type Request struct {
+	Participants []Participant
+	// From and To bound the slots, a slot starts at or after From and ends at or before To.
+	From, To *gdatetime.GDateTime
+	// Duration is the length of the meeting.
+	Duration time.Duration
+	// Step is the interval between candidate slot starts, aligned to UTC, DefaultStep when zero.
+	Step time.Duration
+	// Limit is the maximum number of slots returned, all when zero.
+	Limit int
+}
+
+// Slot is a time when every participant is available.
+type Slot struct {
+	Start, End *gdatetime.GDateTime // in UTC
+	Fits       []Fit                // one per participant, in the order of the Request
+	// Score is the largest absolute Lateness of the participants, lower is more convenient.
+	Score time.Duration
+	// Total is the sum of the absolute Lateness of the participants, it breaks ties of Score.
+	Total time.Duration
+}
+
+// Fit tells how a Slot falls in the working day of one participant.
+type Fit struct {
+	Participant string
+	// Start and End are the slot in the zone of the participant.
+	Start, End *gdatetime.GDateTime
+	// Lateness is how far the middle of the slot is from the middle of the working day,
+	// positive when the slot is late in the day for the participant and negative when it is early.
+	Lateness time.Duration
+}
+
+// participantPlan is a Participant with its location and working hours resolved.
+type participantPlan struct {
+	Participant
+	loc       *time.Location
+	overnight bool
+	workDays  map[time.Weekday]bool
+}
+
+// FindSlots returns the slots of the Request when every participant is inside working hours and not busy,
+// the lowest Score first, then the lowest Total, then in time order.
+func FindSlots(req Request) ([]Slot, error) {
+	plans, err := validate(req)
+	if err != nil {
+		return nil, err
+	}
+	step := req.Step
+	if step == 0 {
+		step = DefaultStep
+	}
+
+	var slots []Slot
+	from := req.From.ToTime()
+	start := from.Truncate(step)
+	if start.Before(from) {
+		start = start.Add(step)
+	}
+	for ; !start.Add(req.Duration).After(req.To.ToTime()); start = start.Add(step) {
+		slotStart := gdatetime.Create(start.UTC())
+		slotEnd := gdatetime.Create(start.Add(req.Duration).UTC())
+		if slot, ok := fitAll(plans, slotStart, slotEnd); ok {
+			slots = append(slots, slot)
+		}
+	}
+
+	sort.SliceStable(slots, func(i, j int) bool {
+		if slots[i].Score != slots[j].Score {
+			return slots[i].Score < slots[j].Score
+		}
+		return slots[i].Total < slots[j].Total
+	})
+	if req.Limit > 0 && len(slots) > req.Limit {
+		slots = slots[:req.Limit]
+	}
+	return slots, nil
+}
+
+// validate checks the Request and resolves the zones and working hours of the participants.
+func validate(req Request) ([]participantPlan, error) {
+	if len(req.Participants) == 0 {
+		return nil, ErrNoParticipants
+	}
+	if req.From == nil || req.To == nil || req.To.IsBefore(req.From) {
+		return nil, fmt.Errorf("%w: the range needs From before To", ErrInvalidRequest)
+	}
+	if req.Duration <= 0 || req.Step < 0 {
+		return nil, fmt.Errorf("%w: duration and step must be positive", ErrInvalidRequest)
+	}
+
+	plans := make([]participantPlan, 0, len(req.Participants))
+	for _, p := range req.Participants {
+		loc, err := gdatetime.LoadLocation(p.Zone)
+		if err != nil {
+			return nil, fmt.Errorf("planner: participant %q: %w", p.Name, err)
+		}
+		if p.WorkStart == p.WorkEnd {
+			var zero gdatetime.LocalTime
+			if p.WorkStart != zero {
+				return nil, fmt.Errorf("%w: participant %q has an empty working day", ErrInvalidRequest, p.Name)
+			}
+			p.WorkStart, p.WorkEnd = defaultWorkStart, defaultWorkEnd
+		}
+		workDays := p.WorkDays
+		if len(workDays) == 0 {
+			workDays = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+		}
+		plan := participantPlan{Participant: p, loc: loc, overnight: p.WorkEnd.IsBefore(p.WorkStart), workDays: map[time.Weekday]bool{}}
+		for _, day := range workDays {
+			plan.workDays[day] = true
+		}
+		plans = append(plans, plan)
+	}
+	return plans, nil
+}
+
+// fitAll returns the Slot from start to end if every participant can attend it.
+func fitAll(plans []participantPlan, start, end *gdatetime.GDateTime) (Slot, bool) {
+	slot := Slot{Start: start, End: end, Fits: make([]Fit, 0, len(plans))}
+	for _, plan := range plans {
+		fit, ok := plan.fit(start, end)
+		if !ok {
+			return Slot{}, false
+		}
+		slot.Fits = append(slot.Fits, fit)
+		lateness := max(fit.Lateness, -fit.Lateness)
+		slot.Score = max(slot.Score, lateness)
+		slot.Total += lateness
+	}
+	return slot, true
+}
+
+// fit checks that the slot is inside one working day of the participant and clear of the busy intervals.
+func (plan participantPlan) fit(start, end *gdatetime.GDateTime) (Fit, bool) {
+	for _, busy := range plan.Busy {
+		if start.IsBefore(busy.End) && busy.Start.IsBefore(end) {
+			return Fit{}, false
+		}
+	}
+
+	localStart := start.InLocation(plan.loc)
+	day := localStart.StartOfDay()
+	days := []*gdatetime.GDateTime{day}
+	if plan.overnight {
+		// the slot can also be in the working day that started the day before
+		days = append(days, day.MinusDays(1))
+	}
+	for _, day := range days {
+		if !plan.workDays[time.Weekday(day.GetDayOfWeek())] {
+			continue
+		}
+		workStart, workEnd := plan.workingDay(day.ToLocalDate())
+		if start.IsWithinRange(workStart, workEnd) && end.IsWithinRange(workStart, workEnd) {
+			middle := workStart.ToTime().Add(workEnd.ToTime().Sub(workStart.ToTime()) / 2)
+			slotMiddle := start.ToTime().Add(end.ToTime().Sub(start.ToTime()) / 2)
+			return Fit{
+				Participant: plan.Name,
+				Start:       localStart,
+				End:         end.InLocation(plan.loc),
+				Lateness:    slotMiddle.Sub(middle),
+			}, true
+		}
+	}
+	return Fit{}, false
+}
+
+// workingDay returns the working hours of the participant on the local date.
+func (plan participantPlan) workingDay(date gdatetime.LocalDate) (*gdatetime.GDateTime, *gdatetime.GDateTime) {
+	endDate := date
+	if plan.overnight {
+		endDate = date.PlusDays(1)
+	}
+	workStart, _ := date.AtTime(plan.WorkStart).AtZone(plan.loc)
+	workEnd, _ := endDate.AtTime(plan.WorkEnd).AtZone(plan.loc)
+	return workStart, workEnd
+}
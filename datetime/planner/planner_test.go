@@ -0,0 +1,189 @@
+package planner
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/linsongze/go-date-time/datetime/gdatetime"
+)
+
+func utc(t *testing.T, day, hour, minute int) *gdatetime.GDateTime {
+	t.Helper()
+	gdt, err := gdatetime.OfInLocation(2024, 6, day, hour, minute, 0, 0, time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return gdt
+}
+
+func localTime(t *testing.T, hour int) gdatetime.LocalTime {
+	t.Helper()
+	lt, err := gdatetime.LocalTimeOf(hour, 0, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return lt
+}
+
+func skipWithoutZones(t *testing.T, zones ...string) {
+	t.Helper()
+	for _, zone := range zones {
+		if _, err := gdatetime.LoadLocation(zone); err != nil {
+			t.Skipf("%s not available", zone)
+		}
+	}
+}
+
+func TestFindSlots(t *testing.T) {
+	skipWithoutZones(t, "America/New_York", "Europe/London")
+	// Wednesday 2024-06-05: New York works 13:00-22:00 UTC and London 08:00-17:00 UTC
+	slots, err := FindSlots(Request{
+		Participants: []Participant{{Name: "Ana", Zone: "America/New_York"}, {Name: "Tom", Zone: "Europe/London"}},
+		From:         utc(t, 5, 0, 0),
+		To:           utc(t, 6, 0, 0),
+		Duration:     time.Hour,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(slots) != 7 {
+		t.Fatalf("FindSlots returned %d slots, want 7 from 13:00 to 16:00 UTC", len(slots))
+	}
+	for _, slot := range slots {
+		if slot.Start.IsBefore(utc(t, 5, 13, 0)) || slot.End.IsAfter(utc(t, 5, 17, 0)) || slot.Start.ToTime().Location() != time.UTC {
+			t.Errorf("slot %s - %s is outside the shared working hours", slot.Start.ToDateTimeString(), slot.End.ToDateTimeString())
+		}
+	}
+
+	best := slots[0]
+	if !best.Start.ToTime().Equal(utc(t, 5, 14, 30).ToTime()) || best.Score != 150*time.Minute {
+		t.Errorf("best slot = %s with score %v, want 14:30 UTC with 2h30m", best.Start.ToDateTimeString(), best.Score)
+	}
+	ana, tom := best.Fits[0], best.Fits[1]
+	if ana.Participant != "Ana" || ana.Start.GetHour() != 10 || ana.Lateness != -150*time.Minute {
+		t.Errorf("fit for Ana = %+v, want 10:30 local and 2h30m early", ana)
+	}
+	if tom.Participant != "Tom" || tom.Start.GetHour() != 15 || tom.Lateness != 150*time.Minute {
+		t.Errorf("fit for Tom = %+v, want 15:30 local and 2h30m late", tom)
+	}
+	for i := 1; i < len(slots); i++ {
+		if slots[i].Score < slots[i-1].Score {
+			t.Fatal("slots should be ranked by score")
+		}
+	}
+}
+
+func TestFindSlotsBusyAndLimit(t *testing.T) {
+	skipWithoutZones(t, "America/New_York", "Europe/London")
+	req := Request{
+		Participants: []Participant{
+			{Name: "Ana", Zone: "America/New_York"},
+			{Name: "Tom", Zone: "Europe/London", Busy: []Busy{{Start: utc(t, 5, 14, 0), End: utc(t, 5, 15, 0)}}},
+		},
+		From:     utc(t, 5, 0, 0),
+		To:       utc(t, 6, 0, 0),
+		Duration: time.Hour,
+	}
+	slots, err := FindSlots(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var starts []int
+	for _, slot := range slots {
+		starts = append(starts, slot.Start.GetHour()*60+slot.Start.GetMinute())
+	}
+	if len(starts) != 4 || starts[0] != 15*60 {
+		t.Errorf("slot starts in minutes = %v, want 13:00, 15:00, 15:30 and 16:00 with 15:00 first", starts)
+	}
+	for _, start := range starts {
+		if start > 13*60 && start < 15*60 {
+			t.Errorf("slot at minute %d overlaps the busy interval", start)
+		}
+	}
+
+	req.Limit = 2
+	if slots, _ := FindSlots(req); len(slots) != 2 {
+		t.Errorf("FindSlots with Limit 2 returned %d slots", len(slots))
+	}
+}
+
+func TestFindSlotsWorkDays(t *testing.T) {
+	skipWithoutZones(t, "America/New_York", "Europe/London")
+	req := Request{
+		Participants: []Participant{{Name: "Ana", Zone: "America/New_York"}, {Name: "Tom", Zone: "Europe/London"}},
+		From:         utc(t, 8, 0, 0), // Saturday
+		To:           utc(t, 9, 0, 0),
+		Duration:     time.Hour,
+	}
+	if slots, _ := FindSlots(req); len(slots) != 0 {
+		t.Errorf("FindSlots on a Saturday returned %d slots, want none", len(slots))
+	}
+	req.Participants[0].WorkDays = []time.Weekday{time.Saturday}
+	req.Participants[1].WorkDays = []time.Weekday{time.Saturday}
+	if slots, _ := FindSlots(req); len(slots) != 7 {
+		t.Errorf("FindSlots on a Saturday working day returned %d slots, want 7", len(slots))
+	}
+}
+
+func TestFindSlotsOvernight(t *testing.T) {
+	skipWithoutZones(t, "America/New_York", "Asia/Shanghai")
+	// the night shift in Shanghai runs from 22:00 to 06:00, 14:00-22:00 UTC, New York works 13:00-22:00 UTC
+	slots, err := FindSlots(Request{
+		Participants: []Participant{
+			{Name: "Ana", Zone: "America/New_York"},
+			{Name: "Wei", Zone: "Asia/Shanghai", WorkStart: localTime(t, 22), WorkEnd: localTime(t, 6)},
+		},
+		From:     utc(t, 5, 0, 0),
+		To:       utc(t, 6, 0, 0),
+		Duration: time.Hour,
+		Step:     time.Hour,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(slots) != 8 {
+		t.Errorf("FindSlots with a night shift returned %d slots, want 8 from 14:00 to 21:00 UTC", len(slots))
+	}
+}
+
+func TestFindSlotsErrors(t *testing.T) {
+	skipWithoutZones(t, "Europe/London")
+	valid := Request{
+		Participants: []Participant{{Name: "Tom", Zone: "Europe/London"}},
+		From:         utc(t, 5, 0, 0),
+		To:           utc(t, 6, 0, 0),
+		Duration:     time.Hour,
+	}
+	if _, err := FindSlots(valid); err != nil {
+		t.Fatalf("FindSlots of a valid request failed: %v", err)
+	}
+
+	noParticipants := valid
+	noParticipants.Participants = nil
+	if _, err := FindSlots(noParticipants); !errors.Is(err, ErrNoParticipants) {
+		t.Errorf("no participants: got %v", err)
+	}
+
+	unknownZone := valid
+	unknownZone.Participants = []Participant{{Name: "Zed", Zone: "Mars/Olympus_Mons"}}
+	if _, err := FindSlots(unknownZone); !errors.Is(err, gdatetime.ErrUnknownZone) {
+		t.Errorf("unknown zone: got %v", err)
+	}
+
+	for name, mutate := range map[string]func(*Request){
+		"zero duration":  func(r *Request) { r.Duration = 0 },
+		"negative step":  func(r *Request) { r.Step = -time.Minute },
+		"reversed range": func(r *Request) { r.From, r.To = r.To, r.From },
+		"missing range":  func(r *Request) { r.To = nil },
+		"empty working day": func(r *Request) {
+			r.Participants = []Participant{{Zone: "Europe/London", WorkStart: localTime(t, 9), WorkEnd: localTime(t, 9)}}
+		},
+	} {
+		req := valid
+		mutate(&req)
+		if _, err := FindSlots(req); !errors.Is(err, ErrInvalidRequest) {
+			t.Errorf("%s: got %v, want ErrInvalidRequest", name, err)
+		}
+	}
+}
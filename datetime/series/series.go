@@ -0,0 +1,217 @@
+// Package series resamples irregular timestamped values to a regular cadence, such as sensor readings to one value a minute.
+//
+//	perMinute, err := series.Resample(readings, series.Resampling[float64]{
+//		Step:      1,
+//		Unit:      timeunit.MINUTES,
+//		Aggregate: series.Mean[float64],
+//		Fill:      series.Linear[float64],
+//	})
+//
+// The buckets are aligned to the wall clock of the chosen zone like GDateTime.FloorTo, so hourly buckets start
+// on the hour and daily ones at local midnight. Each output point is at the start of its bucket.
+package series
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/linsongze/go-date-time/datetime/gdatetime"
+	"github.com/linsongze/go-date-time/datetime/timeunit"
+)
+
+// ErrInvalidResampling is returned for a Resampling with a step below 1, an unsupported unit or no Aggregate.
+var ErrInvalidResampling = errors.New("series: invalid resampling")
+
+// Point is a value at an instant.
+type Point[T any] struct {
+	At *gdatetime.GDateTime
+	V  T
+}
+
+// Number is the type set of the values that can be added and interpolated.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~float32 | ~float64
+}
+
+// Aggregator combines the values of a bucket, in time order, into one. It is never called with no values.
+type Aggregator[T any] func(values []T) T
+
+// Filler returns the value of an empty bucket starting at the instant from the nearest non-empty buckets before
+// and after it, nil at the ends of the series. It returns false to leave the bucket out.
+type Filler[T any] func(at *gdatetime.GDateTime, prev, next *Point[T]) (T, bool)
+
+// Resampling describes the cadence of Resample.
+type Resampling[T any] struct {
+	// Step and Unit are the length of a bucket, such as 15 MINUTES or 1 MONTHS.
+	Step int
+	Unit timeunit.TimeUnit
+	// Location is the zone whose wall clock the buckets are aligned to, the location of the first point when nil.
+	Location *time.Location
+	// Aggregate combines the values of a bucket when downsampling, such as Mean or Last.
+	Aggregate Aggregator[T]
+	// Fill makes the value of an empty bucket when upsampling or in a gap, such as ForwardFill or Linear.
+	// Empty buckets are left out when it is nil.
+	Fill Filler[T]
+}
+
+// Resample returns one point per bucket of r from the bucket of the earliest point to the bucket of the latest one,
+// in time order. The points do not need to be sorted, and a bucket holds the points at or after its start
+// and before the start of the next bucket. The bucket starts are the boundaries of GDateTime.FloorTo,
+// so with a step that does not divide the day, such as 7 HOURS, the last bucket of a day ends at midnight.
+func Resample[T any](points []Point[T], r Resampling[T]) ([]Point[T], error) {
+	if r.Step < 1 {
+		return nil, fmt.Errorf("%w: step %d", ErrInvalidResampling, r.Step)
+	}
+	if r.Aggregate == nil {
+		return nil, fmt.Errorf("%w: no Aggregate", ErrInvalidResampling)
+	}
+	if len(points) == 0 {
+		return nil, nil
+	}
+	sorted := slices.Clone(points)
+	slices.SortStableFunc(sorted, func(a, b Point[T]) int { return a.At.ToTime().Compare(b.At.ToTime()) })
+	loc := r.Location
+	if loc == nil {
+		loc = sorted[0].At.ToTime().Location()
+	}
+	first, err := sorted[0].At.InLocation(loc).FloorTo(r.Step, r.Unit)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidResampling, err)
+	}
+	last := sorted[len(sorted)-1].At.PlusNanos(1)
+
+	// bucket starts, each the floor of the end of the previous bucket, so every start is aligned like FloorTo:
+	// a step not dividing the day restarts at midnight and a DST change does not move the boundaries off the wall clock
+	var starts []*gdatetime.GDateTime
+	for start := first; start.IsBefore(last); {
+		starts = append(starts, start)
+		end := start.Plus(r.Step, r.Unit)
+		if next, _ := end.FloorTo(r.Step, r.Unit); next.IsAfter(start) {
+			end = next
+		}
+		start = end
+	}
+	buckets := make([]Point[T], len(starts))
+	filled := make([]bool, len(starts))
+	values := make([]T, 0, len(sorted))
+	i := 0
+	for b, start := range starts {
+		values = values[:0]
+		for i < len(sorted) && (b == len(starts)-1 || sorted[i].At.IsBefore(starts[b+1])) {
+			values = append(values, sorted[i].V)
+			i++
+		}
+		buckets[b] = Point[T]{At: start}
+		if len(values) > 0 {
+			buckets[b].V, filled[b] = r.Aggregate(values), true
+		}
+	}
+
+	// next[b] is the index of the first filled bucket after b, -1 if none, so a long gap is filled in linear time
+	next := make([]int, len(buckets))
+	following := -1
+	for b := len(buckets) - 1; b >= 0; b-- {
+		next[b] = following
+		if filled[b] {
+			following = b
+		}
+	}
+
+	result := make([]Point[T], 0, len(buckets))
+	var prev *Point[T]
+	for b := range buckets {
+		if filled[b] {
+			prev = &buckets[b]
+			result = append(result, buckets[b])
+			continue
+		}
+		if r.Fill == nil {
+			continue
+		}
+		var nextPoint *Point[T]
+		if next[b] >= 0 {
+			nextPoint = &buckets[next[b]]
+		}
+		if v, ok := r.Fill(buckets[b].At, prev, nextPoint); ok {
+			result = append(result, Point[T]{At: buckets[b].At, V: v})
+		}
+	}
+	return result, nil
+}
+
+// First returns the earliest value of a bucket.
+func First[T any](values []T) T {
+	return values[0]
+}
+
+// Last returns the latest value of a bucket.
+func Last[T any](values []T) T {
+	return values[len(values)-1]
+}
+
+// Sum returns the sum of the values of a bucket.
+func Sum[T Number](values []T) T {
+	var sum T
+	for _, v := range values {
+		sum += v
+	}
+	return sum
+}
+
+// Mean returns the mean of the values of a bucket, rounded to the nearest integer for an integer type.
+func Mean[T Number](values []T) T {
+	var sum float64
+	for _, v := range values {
+		sum += float64(v)
+	}
+	return fromFloat[T](sum / float64(len(values)))
+}
+
+// Min returns the smallest value of a bucket.
+func Min[T cmp.Ordered](values []T) T {
+	return slices.Min(values)
+}
+
+// Max returns the largest value of a bucket.
+func Max[T cmp.Ordered](values []T) T {
+	return slices.Max(values)
+}
+
+// Count returns the number of values in a bucket.
+func Count[T Number](values []T) T {
+	return T(len(values))
+}
+
+// ForwardFill fills an empty bucket with the value of the bucket before it, and leaves out the empty buckets at the start.
+func ForwardFill[T any](_ *gdatetime.GDateTime, prev, _ *Point[T]) (T, bool) {
+	if prev == nil {
+		var zero T
+		return zero, false
+	}
+	return prev.V, true
+}
+
+// Linear fills an empty bucket by linear interpolation in time between the buckets around it,
+// rounded to the nearest integer for an integer type. The empty buckets at the ends are left out.
+func Linear[T Number](at *gdatetime.GDateTime, prev, next *Point[T]) (T, bool) {
+	if prev == nil || next == nil {
+		var zero T
+		return zero, false
+	}
+	from, to := prev.At.ToTime(), next.At.ToTime()
+	fraction := float64(at.ToTime().Sub(from)) / float64(to.Sub(from))
+	return fromFloat[T](float64(prev.V) + (float64(next.V)-float64(prev.V))*fraction), true
+}
+
+// fromFloat converts f to T, rounding to the nearest integer when T is an integer type.
+func fromFloat[T Number](f float64) T {
+	half := 0.5
+	if T(half) == 0 {
+		return T(math.Round(f))
+	}
+	return T(f)
+}
@@ -0,0 +1,185 @@
+package series
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/linsongze/go-date-time/datetime/gdatetime"
+	"github.com/linsongze/go-date-time/datetime/timeunit"
+)
+
+func at(hour, minute, second int) *gdatetime.GDateTime {
+	return gdatetime.Create(time.Date(2024, 6, 1, hour, minute, second, 0, time.UTC))
+}
+
+func TestResampleDownsample(t *testing.T) {
+	readings := []Point[int]{
+		{at(10, 0, 10), 1},
+		{at(10, 0, 50), 3},
+		{at(10, 3, 0), 7},
+		{at(10, 1, 30), 5}, // out of order
+	}
+	tests := []struct {
+		name string
+		agg  Aggregator[int]
+		want []int
+	}{
+		{"first", First[int], []int{1, 5, 7}},
+		{"last", Last[int], []int{3, 5, 7}},
+		{"sum", Sum[int], []int{4, 5, 7}},
+		{"mean", Mean[int], []int{2, 5, 7}},
+		{"min", Min[int], []int{1, 5, 7}},
+		{"max", Max[int], []int{3, 5, 7}},
+		{"count", Count[int], []int{2, 1, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Resample(readings, Resampling[int]{Step: 1, Unit: timeunit.MINUTES, Aggregate: tt.agg})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var values []int
+			for _, p := range got {
+				values = append(values, p.V)
+			}
+			if !slices.Equal(values, tt.want) {
+				t.Errorf("values = %v, want %v", values, tt.want)
+			}
+			var starts []time.Time
+			for _, p := range got {
+				starts = append(starts, p.At.ToTime())
+			}
+			// the empty bucket at 10:02 is left out without Fill
+			if want := []time.Time{at(10, 0, 0).ToTime(), at(10, 1, 0).ToTime(), at(10, 3, 0).ToTime()}; !slices.EqualFunc(starts, want, time.Time.Equal) {
+				t.Errorf("starts = %v, want %v", starts, want)
+			}
+		})
+	}
+}
+
+func TestResampleUpsample(t *testing.T) {
+	readings := []Point[float64]{{at(10, 0, 0), 10}, {at(11, 0, 0), 20}}
+	linear, err := Resample(readings, Resampling[float64]{Step: 15, Unit: timeunit.MINUTES, Aggregate: Last[float64], Fill: Linear[float64]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var values []float64
+	for _, p := range linear {
+		values = append(values, p.V)
+	}
+	if want := []float64{10, 12.5, 15, 17.5, 20}; !slices.Equal(values, want) {
+		t.Errorf("Linear = %v, want %v", values, want)
+	}
+
+	forward, _ := Resample(readings, Resampling[float64]{Step: 15, Unit: timeunit.MINUTES, Aggregate: Last[float64], Fill: ForwardFill[float64]})
+	values = values[:0]
+	for _, p := range forward {
+		values = append(values, p.V)
+	}
+	if want := []float64{10, 10, 10, 10, 20}; !slices.Equal(values, want) {
+		t.Errorf("ForwardFill = %v, want %v", values, want)
+	}
+
+	ints, _ := Resample([]Point[int]{{at(10, 0, 0), 0}, {at(10, 3, 0), 1}}, Resampling[int]{Step: 1, Unit: timeunit.MINUTES, Aggregate: Last[int], Fill: Linear[int]})
+	var rounded []int
+	for _, p := range ints {
+		rounded = append(rounded, p.V)
+	}
+	if want := []int{0, 0, 1, 1}; !slices.Equal(rounded, want) {
+		t.Errorf("Linear on ints = %v, want %v", rounded, want)
+	}
+}
+
+func TestResampleCalendarZone(t *testing.T) {
+	shanghai := time.FixedZone("CST", 8*3600)
+	// 15:00 and 17:00 UTC on June 1 are on June 1 and June 2 in Shanghai
+	readings := []Point[int]{{at(15, 0, 0), 1}, {at(17, 0, 0), 2}, {at(18, 0, 0), 3}}
+	got, err := Resample(readings, Resampling[int]{Step: 1, Unit: timeunit.DAYS, Location: shanghai, Aggregate: Sum[int]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[0].V != 1 || got[1].V != 5 {
+		t.Fatalf("Resample by days in Shanghai = %+v, want 1 and 5", got)
+	}
+	if want := time.Date(2024, 6, 2, 0, 0, 0, 0, shanghai); !got[1].At.ToTime().Equal(want) || got[1].At.ToTime().Location() != shanghai {
+		t.Errorf("the second bucket should start at %v, got %v", want, got[1].At.ToTime())
+	}
+
+	hourly, _ := Resample(readings, Resampling[int]{Step: 1, Unit: timeunit.HOURS, Aggregate: Sum[int]})
+	if len(hourly) != 3 || hourly[0].At.ToTime().Location() != time.UTC {
+		t.Errorf("without a Location the buckets should be in the zone of the first point, got %+v", hourly)
+	}
+}
+
+func TestResampleAlignsToFloorTo(t *testing.T) {
+	// 7 hour buckets restart at midnight, so 01:00 on June 2 is not in the 21:00 bucket of June 1
+	readings := []Point[int]{{at(20, 0, 0), 1}, {gdatetime.Create(time.Date(2024, 6, 2, 1, 0, 0, 0, time.UTC)), 2}}
+	got, err := Resample(readings, Resampling[int]{Step: 7, Unit: timeunit.HOURS, Aggregate: Sum[int], Fill: ForwardFill[int]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []time.Time{
+		time.Date(2024, 6, 1, 14, 0, 0, 0, time.UTC),
+		time.Date(2024, 6, 1, 21, 0, 0, 0, time.UTC),
+		time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC),
+	}
+	if len(got) != len(want) || got[0].V != 1 || got[2].V != 2 {
+		t.Fatalf("Resample by 7 hours = %+v, want 1, 1 and 2", got)
+	}
+	for i, p := range got {
+		if !p.At.ToTime().Equal(want[i]) {
+			t.Errorf("bucket %d should start at %v, got %v", i, want[i], p.At.ToTime())
+		}
+	}
+
+	// Lord Howe Island moves its clocks back by 30 minutes at 02:00 on April 7, 2024
+	lordHowe, err := time.LoadLocation("Australia/Lord_Howe")
+	if err != nil {
+		t.Skip("Australia/Lord_Howe not available")
+	}
+	readings = []Point[int]{
+		{gdatetime.Create(time.Date(2024, 4, 7, 0, 30, 0, 0, lordHowe)), 1},
+		{gdatetime.Create(time.Date(2024, 4, 7, 3, 30, 0, 0, lordHowe)), 2},
+	}
+	got, err = Resample(readings, Resampling[int]{Step: 1, Unit: timeunit.HOURS, Aggregate: Sum[int]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range got {
+		if wall := p.At.ToTime(); wall.Minute() != 0 {
+			t.Errorf("bucket starts should stay on the hour, got %v", wall)
+		}
+	}
+	if last := got[len(got)-1]; last.V != 2 || last.At.ToTime().Hour() != 3 {
+		t.Errorf("the last bucket should start at 03:00 and hold 2, got %+v", last)
+	}
+}
+
+func TestResampleErrors(t *testing.T) {
+	points := []Point[int]{{at(10, 0, 0), 1}}
+	if _, err := Resample(points, Resampling[int]{Step: 0, Unit: timeunit.MINUTES, Aggregate: Sum[int]}); !errors.Is(err, ErrInvalidResampling) {
+		t.Errorf("a zero step should fail with ErrInvalidResampling, got %v", err)
+	}
+	if _, err := Resample(points, Resampling[int]{Step: 1, Unit: timeunit.MINUTES}); !errors.Is(err, ErrInvalidResampling) {
+		t.Errorf("a missing Aggregate should fail with ErrInvalidResampling, got %v", err)
+	}
+	if _, err := Resample(points, Resampling[int]{Step: 1, Unit: timeunit.TimeUnit(99), Aggregate: Sum[int]}); !errors.Is(err, ErrInvalidResampling) {
+		t.Errorf("an unknown unit should fail with ErrInvalidResampling, got %v", err)
+	}
+	if got, err := Resample(nil, Resampling[int]{Step: 1, Unit: timeunit.MINUTES, Aggregate: Sum[int]}); err != nil || got != nil {
+		t.Errorf("no points should give no points, got %v, %v", got, err)
+	}
+}
+
+func BenchmarkResampleLongGap(b *testing.B) {
+	// 30 days without readings at a 1-minute cadence
+	readings := []Point[float64]{{at(0, 0, 0), 0}, {at(0, 0, 0).PlusDays(30), 1}}
+	r := Resampling[float64]{Step: 1, Unit: timeunit.MINUTES, Aggregate: Last[float64], Fill: Linear[float64]}
+	for i := 0; i < b.N; i++ {
+		if _, err := Resample(readings, r); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
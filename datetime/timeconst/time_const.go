@@ -4,6 +4,9 @@ const NANO_PER_SECOND int64 = 1000000000
 const SECONDS_PER_MINUTE = 60
 const SECONDS_PER_HOUR = 3600
 
+const MIN_YEAR = -999_999_999
+const MAX_YEAR = 999_999_999
+
 const MAX_HOUR = 23
 const MAX_MINUTE = 59
 const MAX_SECOND = 59
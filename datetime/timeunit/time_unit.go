@@ -11,4 +11,11 @@ const (
 	HOURS
 	HALF_DAYS
 	DAYS
+	WEEKS
+	MONTHS
+	YEARS
+	QUARTERS
+	HALF_YEARS
+	DECADES
+	CENTURIES
 )
@@ -0,0 +1,11 @@
+// Package tzdata embeds the IANA time zone database in the program as a fallback,
+// for systems without zoneinfo files such as Windows or minimal containers.
+//
+// Importing it is opt-in and adds about 450 KB to the binary:
+//
+//	import _ "github.com/linsongze/go-date-time/datetime/tzdata"
+//
+// The same result is obtained without this import by building with -tags timetzdata.
+package tzdata
+
+import _ "time/tzdata"
@@ -0,0 +1,203 @@
+// Package window groups instants into time-series windows: tumbling windows of a fixed size,
+// hopping windows of a fixed size starting at a fixed interval, and calendar days, weeks and months in a time zone.
+//
+//	spec, _ := window.Tumbling(5 * time.Minute)
+//	w := spec.Assign(event)[0]              // the 5-minute window of the event
+//	for w := range spec.Windows(from, to) { // every window overlapping [from, to)
+//		...
+//	}
+//
+// Windows are half-open, Start inclusive and End exclusive, and every window has a Key that is the same
+// for the same window of a Spec, to group values in a map or a database.
+package window
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"time"
+
+	"github.com/linsongze/go-date-time/datetime/gdatetime"
+)
+
+// ErrInvalidSpec is returned for a window size or hop that is not positive, a hop longer than the size,
+// an unknown calendar unit or a nil location.
+var ErrInvalidSpec = errors.New("window: invalid spec")
+
+// CalendarUnit is the length of a calendar window.
+type CalendarUnit int
+
+const (
+	// Day windows start at midnight, from StartOfDay.
+	Day CalendarUnit = iota
+	// Week windows start on Monday at midnight, from StartOfWeekFromMonday.
+	Week
+	// Month windows start on the first of the month at midnight, from StartOfMonth.
+	Month
+)
+
+// String returns the name of the CalendarUnit.
+func (u CalendarUnit) String() string {
+	switch u {
+	case Day:
+		return "Day"
+	case Week:
+		return "Week"
+	case Month:
+		return "Month"
+	default:
+		return fmt.Sprintf("CalendarUnit(%d)", int(u))
+	}
+}
+
+// Window is a window of a Spec, with the key identifying it.
+type Window struct {
+	gdatetime.Interval
+	// Key is "2024-06-01T10:00:00Z/2024-06-01T10:05:00Z" for tumbling and hopping windows, always in UTC,
+	// and "2024-06-01", "2024-W22" (the ISO week) or "2024-06" for calendar windows, in the zone of the Spec.
+	Key string
+}
+
+// Spec describes how time is cut into windows. The zero Spec is not valid, use Tumbling, Hopping or Calendar.
+type Spec struct {
+	size, hop time.Duration // tumbling and hopping windows
+	unit      CalendarUnit  // calendar windows, when loc is not nil
+	loc       *time.Location
+}
+
+// Tumbling returns the Spec of consecutive windows of the size, such as 5 minutes.
+// Like time.Time.Truncate, the windows are aligned to the zero time, so a size dividing a day starts a window at UTC midnight.
+func Tumbling(size time.Duration) (Spec, error) {
+	return Hopping(size, size)
+}
+
+// Hopping returns the Spec of windows of the size starting every hop, such as 1 hour windows every 15 minutes,
+// so an instant is in size/hop windows, rounded up. The window starts are aligned like Tumbling.
+func Hopping(size, hop time.Duration) (Spec, error) {
+	if size <= 0 || hop <= 0 || hop > size {
+		return Spec{}, fmt.Errorf("%w: size %v and hop %v", ErrInvalidSpec, size, hop)
+	}
+	return Spec{size: size, hop: hop}, nil
+}
+
+// Calendar returns the Spec of the days, weeks or months of the location, such as the days of the zone of a tenant.
+// The windows follow the wall clock, so a day is 23 or 25 hours long across a DST change.
+func Calendar(unit CalendarUnit, loc *time.Location) (Spec, error) {
+	if loc == nil {
+		return Spec{}, fmt.Errorf("%w: %w", ErrInvalidSpec, gdatetime.ErrNilLocation)
+	}
+	if unit < Day || unit > Month {
+		return Spec{}, fmt.Errorf("%w: unknown calendar unit %v", ErrInvalidSpec, unit)
+	}
+	return Spec{unit: unit, loc: loc}, nil
+}
+
+// String describes the Spec, such as "Tumbling(5m0s)", "Hopping(1h0m0s, 15m0s)" or "Calendar(Day, Asia/Shanghai)".
+func (s Spec) String() string {
+	switch {
+	case s.loc != nil:
+		return fmt.Sprintf("Calendar(%v, %s)", s.unit, s.loc)
+	case s.size == s.hop:
+		return fmt.Sprintf("Tumbling(%v)", s.size)
+	default:
+		return fmt.Sprintf("Hopping(%v, %v)", s.size, s.hop)
+	}
+}
+
+// Assign returns the windows containing the instant, by start: one for tumbling and calendar windows,
+// and every overlapping one for hopping windows.
+func (s Spec) Assign(gdt *gdatetime.GDateTime) []Window {
+	var windows []Window
+	for w := range s.Windows(gdt, gdt.PlusNanos(1)) {
+		windows = append(windows, w)
+	}
+	return windows
+}
+
+// Windows returns an iterator over the windows overlapping [from, to), by start.
+func (s Spec) Windows(from, to *gdatetime.GDateTime) iter.Seq[Window] {
+	return func(yield func(Window) bool) {
+		if s.loc == nil && s.hop <= 0 {
+			return
+		}
+		w := s.first(from)
+		for w.Start.IsBefore(to) {
+			if !yield(w) {
+				return
+			}
+			w = s.next(w)
+		}
+	}
+}
+
+// first returns the first window ending after the instant.
+func (s Spec) first(gdt *gdatetime.GDateTime) Window {
+	if s.loc != nil {
+		local := gdt.InLocation(s.loc)
+		switch s.unit {
+		case Week:
+			return s.calendarWindow(local.StartOfWeekFromMonday())
+		case Month:
+			return s.calendarWindow(local.StartOfMonth())
+		default:
+			return s.calendarWindow(local.StartOfDay())
+		}
+	}
+	t := gdt.ToTime()
+	// the latest window starting at or before the instant, then back to the earliest one still containing it
+	start := t.Truncate(s.hop)
+	for start.Add(s.size - s.hop).After(t) {
+		start = start.Add(-s.hop)
+	}
+	return s.fixedWindow(start)
+}
+
+// next returns the window following w.
+func (s Spec) next(w Window) Window {
+	if s.loc != nil {
+		return s.calendarWindow(w.End)
+	}
+	return s.fixedWindow(w.Start.ToTime().Add(s.hop))
+}
+
+// fixedWindow returns the tumbling or hopping window starting at start.
+func (s Spec) fixedWindow(start time.Time) Window {
+	interval := gdatetime.Interval{Start: gdatetime.Create(start.UTC()), End: gdatetime.Create(start.Add(s.size).UTC())}
+	return Window{Interval: interval, Key: interval.String()}
+}
+
+// calendarWindow returns the calendar window starting at start, a midnight in the location of the Spec.
+func (s Spec) calendarWindow(start *gdatetime.GDateTime) Window {
+	t := start.ToTime()
+	switch s.unit {
+	case Week:
+		year, week := t.ISOWeek()
+		return Window{Interval: gdatetime.Interval{Start: start, End: start.PlusDays(7)}, Key: fmt.Sprintf("%04d-W%02d", year, week)}
+	case Month:
+		return Window{Interval: gdatetime.Interval{Start: start, End: start.PlusMonths(1)}, Key: t.Format("2006-01")}
+	default:
+		return Window{Interval: gdatetime.Interval{Start: start, End: start.PlusDays(1)}, Key: t.Format("2006-01-02")}
+	}
+}
+
+// Bucket is a window with its value.
+type Bucket[T any] struct {
+	Window
+	Value T
+	// Filled tells the window had no value and Value was made by the fill function.
+	Filled bool
+}
+
+// Fill returns a bucket for every window of the spec overlapping [from, to), by start, taking the values by window Key.
+// A window without a value, a gap in the series, gets the value of fill, or the zero value when fill is nil.
+func Fill[T any](s Spec, from, to *gdatetime.GDateTime, values map[string]T, fill func(Window) T) []Bucket[T] {
+	var buckets []Bucket[T]
+	for w := range s.Windows(from, to) {
+		value, ok := values[w.Key]
+		if !ok && fill != nil {
+			value = fill(w)
+		}
+		buckets = append(buckets, Bucket[T]{Window: w, Value: value, Filled: !ok})
+	}
+	return buckets
+}
@@ -0,0 +1,148 @@
+package window
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/linsongze/go-date-time/datetime/gdatetime"
+)
+
+func utc(hour, minute, second int) *gdatetime.GDateTime {
+	return gdatetime.Create(time.Date(2024, 6, 1, hour, minute, second, 0, time.UTC))
+}
+
+func keys(windows []Window) []string {
+	var out []string
+	for _, w := range windows {
+		out = append(out, w.Key)
+	}
+	return out
+}
+
+func TestTumbling(t *testing.T) {
+	spec, err := Tumbling(5 * time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	windows := spec.Assign(utc(10, 7, 30))
+	want := []string{"2024-06-01T10:05:00Z/2024-06-01T10:10:00Z"}
+	if !slices.Equal(keys(windows), want) {
+		t.Errorf("Assign = %v, want %v", keys(windows), want)
+	}
+	// the same window from another zone has the same key
+	shanghai := time.FixedZone("CST", 8*3600)
+	other := spec.Assign(gdatetime.Create(time.Date(2024, 6, 1, 18, 9, 59, 0, shanghai)))
+	if !slices.Equal(keys(other), want) {
+		t.Errorf("Assign in another zone = %v, want %v", keys(other), want)
+	}
+	if edge := spec.Assign(utc(10, 10, 0)); edge[0].Key != "2024-06-01T10:10:00Z/2024-06-01T10:15:00Z" {
+		t.Errorf("the end of a window should start the next one, got %v", edge[0].Key)
+	}
+
+	var all []Window
+	for w := range spec.Windows(utc(10, 3, 0), utc(10, 15, 0)) {
+		all = append(all, w)
+	}
+	if len(all) != 3 || !all[0].Start.ToTime().Equal(utc(10, 0, 0).ToTime()) {
+		t.Errorf("Windows = %v, want the 3 windows from 10:00", keys(all))
+	}
+}
+
+func TestHopping(t *testing.T) {
+	spec, err := Hopping(time.Hour, 15*time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	windows := spec.Assign(utc(10, 20, 0))
+	if len(windows) != 4 {
+		t.Fatalf("an instant should be in 4 windows, got %v", keys(windows))
+	}
+	for i, start := range []*gdatetime.GDateTime{utc(9, 30, 0), utc(9, 45, 0), utc(10, 0, 0), utc(10, 15, 0)} {
+		if !windows[i].Start.ToTime().Equal(start.ToTime()) || windows[i].Duration() != time.Hour || !windows[i].Contains(utc(10, 20, 0)) {
+			t.Errorf("window %d = %v, want the hour from %v", i, windows[i].Key, start.ToTime())
+		}
+	}
+
+	uneven, _ := Hopping(10*time.Minute, 4*time.Minute)
+	if got := uneven.Assign(utc(10, 0, 0)); len(got) != 3 {
+		t.Errorf("a 10 minute window every 4 minutes should give 3 windows, got %v", keys(got))
+	}
+}
+
+func TestCalendar(t *testing.T) {
+	ny, err := gdatetime.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("America/New_York not available")
+	}
+	days, _ := Calendar(Day, ny)
+	// 03:00 UTC on June 1 is still May 31 in New York
+	if got := days.Assign(utc(3, 0, 0)); got[0].Key != "2024-05-31" || got[0].Duration() != 24*time.Hour {
+		t.Errorf("Assign = %v, want the day 2024-05-31", got)
+	}
+	dst := days.Assign(gdatetime.Create(time.Date(2024, 3, 10, 12, 0, 0, 0, ny)))
+	if dst[0].Duration() != 23*time.Hour {
+		t.Errorf("the day of the spring forward should last 23h, got %v", dst[0].Duration())
+	}
+
+	weeks, _ := Calendar(Week, ny)
+	var got []Window
+	for w := range weeks.Windows(gdatetime.Create(time.Date(2024, 12, 25, 0, 0, 0, 0, ny)), gdatetime.Create(time.Date(2025, 1, 7, 0, 0, 0, 0, ny))) {
+		got = append(got, w)
+	}
+	if want := []string{"2024-W52", "2025-W01", "2025-W02"}; !slices.Equal(keys(got), want) {
+		t.Errorf("Windows of weeks = %v, want %v", keys(got), want)
+	}
+	if got[0].Start.ToTime().Weekday() != time.Monday {
+		t.Errorf("weeks should start on Monday, got %v", got[0].Start.ToTime())
+	}
+
+	months, _ := Calendar(Month, ny)
+	if got := months.Assign(utc(3, 0, 0)); got[0].Key != "2024-05" {
+		t.Errorf("Assign of months = %v, want 2024-05", got[0].Key)
+	}
+}
+
+func TestFill(t *testing.T) {
+	spec, _ := Tumbling(5 * time.Minute)
+	counts := map[string]int{}
+	for _, event := range []*gdatetime.GDateTime{utc(10, 1, 0), utc(10, 2, 0), utc(10, 12, 0)} {
+		counts[spec.Assign(event)[0].Key]++
+	}
+	buckets := Fill(spec, utc(10, 0, 0), utc(10, 20, 0), counts, func(Window) int { return -1 })
+	var values []int
+	var filled []bool
+	for _, b := range buckets {
+		values = append(values, b.Value)
+		filled = append(filled, b.Filled)
+	}
+	if want := []int{2, -1, 1, -1}; !slices.Equal(values, want) {
+		t.Errorf("Fill values = %v, want %v", values, want)
+	}
+	if want := []bool{false, true, false, true}; !slices.Equal(filled, want) {
+		t.Errorf("Fill filled = %v, want %v", filled, want)
+	}
+	if zero := Fill(spec, utc(10, 0, 0), utc(10, 10, 0), counts, nil); zero[1].Value != 0 || !zero[1].Filled {
+		t.Errorf("Fill without a fill function should use the zero value, got %+v", zero[1])
+	}
+}
+
+func TestInvalidSpec(t *testing.T) {
+	if _, err := Tumbling(0); !errors.Is(err, ErrInvalidSpec) {
+		t.Errorf("Tumbling(0) should fail with ErrInvalidSpec, got %v", err)
+	}
+	if _, err := Hopping(time.Minute, time.Hour); !errors.Is(err, ErrInvalidSpec) {
+		t.Errorf("a hop longer than the size should fail with ErrInvalidSpec, got %v", err)
+	}
+	if _, err := Calendar(Day, nil); !errors.Is(err, ErrInvalidSpec) || !errors.Is(err, gdatetime.ErrNilLocation) {
+		t.Errorf("a nil location should fail with ErrInvalidSpec and ErrNilLocation, got %v", err)
+	}
+	if _, err := Calendar(CalendarUnit(7), time.UTC); !errors.Is(err, ErrInvalidSpec) {
+		t.Errorf("an unknown unit should fail with ErrInvalidSpec, got %v", err)
+	}
+	var zero Spec
+	for range zero.Windows(utc(0, 0, 0), utc(1, 0, 0)) {
+		t.Fatal("the zero Spec should have no windows")
+	}
+}
@@ -1 +1,3 @@
-module "github.com/linsongze/go-date-time"
\ No newline at end of file
+module github.com/linsongze/go-date-time
+
+go 1.23